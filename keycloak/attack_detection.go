@@ -0,0 +1,34 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+type UserBruteForceStatus struct {
+	NumFailures   int    `json:"numFailures"`
+	Disabled      bool   `json:"disabled"`
+	LastIPFailure string `json:"lastIPFailure"`
+	LastFailure   int64  `json:"lastFailure"` // epoch milliseconds, 0 when the user never failed a login
+}
+
+func (keycloakClient *KeycloakClient) GetUserBruteForceStatus(ctx context.Context, realmId, userId string) (*UserBruteForceStatus, error) {
+	var status UserBruteForceStatus
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/attack-detection/brute-force/users/%s", realmId, userId), &status, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// ClearUserBruteForceStatus resets the login failures of a single user, which unlocks the user if it was temporarily or permanently locked
+func (keycloakClient *KeycloakClient) ClearUserBruteForceStatus(ctx context.Context, realmId, userId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/attack-detection/brute-force/users/%s", realmId, userId), nil)
+}
+
+// ClearRealmBruteForceStatus resets the login failures of every user within the realm
+func (keycloakClient *KeycloakClient) ClearRealmBruteForceStatus(ctx context.Context, realmId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/attack-detection/brute-force/users", realmId), nil)
+}
@@ -2,8 +2,10 @@ package keycloak
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	neturl "net/url"
+	"strconv"
 	"strings"
 )
 
@@ -19,12 +21,41 @@ type Group struct {
 	RealmRoles     []string            `json:"realmRoles,omitempty"`
 	ClientRoles    map[string][]string `json:"clientRoles,omitempty"`
 	Attributes     map[string][]string `json:"attributes"`
+	SubGroupCount  *int                `json:"subGroupCount,omitempty"`
+}
+
+const groupsPageSize = 100
+
+// UnmarshalJSON reads the parentId that Keycloak returns since version 23. ParentId isn't serialized, so it is never
+// sent back to Keycloak.
+func (group *Group) UnmarshalJSON(data []byte) error {
+	type groupJson Group
+
+	var representation struct {
+		groupJson
+		ParentId string `json:"parentId"`
+	}
+
+	err := json.Unmarshal(data, &representation)
+	if err != nil {
+		return err
+	}
+
+	*group = Group(representation.groupJson)
+	group.ParentId = representation.ParentId
+
+	return nil
 }
 
 /*
  * Resolve a subgroup's parent ID using the Keycloak group-by-path API
  */
 func (keycloakClient *KeycloakClient) groupParentId(ctx context.Context, group *Group) (string, error) {
+	// Keycloak 23 and later return the parent of the group
+	if group.ParentId != "" {
+		return group.ParentId, nil
+	}
+
 	var parentPath = strings.TrimSuffix(group.Path, group.Name)
 	// If there is only one group in the path, then this is a top-level group with no parentId
 	if parentPath == "/" {
@@ -113,6 +144,44 @@ func (keycloakClient *KeycloakClient) GetGroups(ctx context.Context, realmId str
 	return groups, nil
 }
 
+// GetGroupChildren returns the full representation of the direct children of a group, fetching them page by page. The
+// top-level groups of the realm are returned when groupId is empty.
+func (keycloakClient *KeycloakClient) GetGroupChildren(ctx context.Context, realmId, groupId string) ([]*Group, error) {
+	var groups []*Group
+
+	path := fmt.Sprintf("/realms/%s/groups", realmId)
+	if groupId != "" {
+		path = fmt.Sprintf("/realms/%s/groups/%s/children", realmId, groupId)
+	}
+
+	values := neturl.Values{}
+	values.Set("briefRepresentation", "false")
+	values.Set("max", strconv.Itoa(groupsPageSize))
+
+	for {
+		values.Set("first", strconv.Itoa(len(groups)))
+
+		var page []*Group
+		err := keycloakClient.getWithQuery(ctx, path, &page, values)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, page...)
+
+		if len(page) < groupsPageSize {
+			break
+		}
+	}
+
+	for _, group := range groups {
+		group.RealmId = realmId
+		group.ParentId = groupId
+	}
+
+	return groups, nil
+}
+
 func (keycloakClient *KeycloakClient) GetGroup(ctx context.Context, realmId, id string) (*Group, error) {
 	return keycloakClient.GetOrganizationGroup(ctx, realmId, "", id)
 }
@@ -157,6 +226,13 @@ func (keycloakClient *KeycloakClient) GetOrganizationGroupByName(ctx context.Con
 		"search": name,
 	}
 
+	// Keycloak 23 and later only return the subgroups leading to the groups that match, so exact matches keep the
+	// result small in large hierarchies. The full representation includes the attributes of the groups.
+	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, Version_23); ok {
+		params["exact"] = "true"
+		params["briefRepresentation"] = "false"
+	}
+
 	var getGroupsUrl string
 	if organizationId != "" {
 		getGroupsUrl = fmt.Sprintf("/realms/%s/organizations/%s/groups", realmId, organizationId)
@@ -178,16 +254,22 @@ func (keycloakClient *KeycloakClient) GetOrganizationGroupByName(ctx context.Con
 	for i := range groups {
 		groupsPtr[i] = &groups[i]
 	}
-	group := getGroupByDFS(name, groupsPtr)
-	if group != nil {
-		group.RealmId = realmId // it's important to set RealmId here because fetching the ParentId depends on it
-		group.OrganizationId = organizationId
-
-		parentId, err := keycloakClient.groupParentId(ctx, group)
-		if err != nil {
-			return nil, err
+	// Keycloak 23 and later may leave out the subgroups of the groups it returns, they are fetched page by page then
+	children := func(group *Group) ([]*Group, error) {
+		if organizationId != "" {
+			return nil, nil
 		}
+		return keycloakClient.GetGroupChildren(ctx, realmId, group.Id)
+	}
 
+	group, parentId, err := getGroupByDFS(name, groupsPtr, "", children)
+	if err != nil {
+		return nil, err
+	}
+
+	if group != nil {
+		group.RealmId = realmId
+		group.OrganizationId = organizationId
 		group.ParentId = parentId
 
 		return group, nil
@@ -197,20 +279,35 @@ func (keycloakClient *KeycloakClient) GetOrganizationGroupByName(ctx context.Con
 }
 
 /*
-Find group by name in groups returned by /groups?search=${group_name}
-If there are multiple groups match the name, it will return the first one it found, using DFS algorithm
+Find group by name in groups returned by /groups?search=${group_name}, along with the ID of its parent.
+If there are multiple groups match the name, it will return the first one it found, using DFS algorithm.
+Groups of the search results whose subgroups weren't returned are descended into with the children function. The
+fetched children are only compared by name and their own children are never fetched, so a lookup doesn't walk the
+whole subtree of a group.
 */
-func getGroupByDFS(groupName string, groups []*Group) *Group {
+func getGroupByDFS(groupName string, groups []*Group, parentId string, children func(group *Group) ([]*Group, error)) (*Group, string, error) {
 	for _, group := range groups {
 		if groupName == group.Name {
-			return group
+			return group, parentId, nil
+		}
+
+		subGroups := group.SubGroups
+		subGroupsChildren := children
+		if len(subGroups) == 0 && group.SubGroupCount != nil && *group.SubGroupCount > 0 && children != nil {
+			var err error
+			subGroups, err = children(group)
+			if err != nil {
+				return nil, "", err
+			}
+			subGroupsChildren = nil
 		}
-		groupFound := getGroupByDFS(groupName, group.SubGroups)
-		if groupFound != nil {
-			return groupFound
+
+		groupFound, groupFoundParentId, err := getGroupByDFS(groupName, subGroups, group.Id, subGroupsChildren)
+		if err != nil || groupFound != nil {
+			return groupFound, groupFoundParentId, err
 		}
 	}
-	return nil
+	return nil, "", nil
 }
 
 /*
@@ -261,6 +358,19 @@ func (keycloakClient *KeycloakClient) UpdateGroup(ctx context.Context, group *Gr
 	return keycloakClient.put(ctx, updateGroupUrl, group)
 }
 
+// MoveGroup moves an existing group under group.ParentId, or to the top level when it is empty. Keycloak also updates
+// the name, description and attributes of the group, so they must be set.
+func (keycloakClient *KeycloakClient) MoveGroup(ctx context.Context, group *Group) error {
+	moveGroupUrl := fmt.Sprintf("/realms/%s/groups", group.RealmId)
+	if group.ParentId != "" {
+		moveGroupUrl = fmt.Sprintf("/realms/%s/groups/%s/children", group.RealmId, group.ParentId)
+	}
+
+	_, _, err := keycloakClient.post(ctx, moveGroupUrl, group)
+
+	return err
+}
+
 func (keycloakClient *KeycloakClient) DeleteGroup(ctx context.Context, realmId, id string) error {
 	return keycloakClient.DeleteOrganizationGroup(ctx, realmId, "", id)
 }
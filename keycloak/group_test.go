@@ -0,0 +1,118 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGroupUnmarshalParentId(t *testing.T) {
+	var group Group
+	err := json.Unmarshal([]byte(`{"id": "child", "name": "child", "path": "/parent/child", "parentId": "parent", "subGroupCount": 2}`), &group)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if group.Id != "child" || group.Path != "/parent/child" || group.ParentId != "parent" {
+		t.Errorf("unexpected group %+v", group)
+	}
+	if group.SubGroupCount == nil || *group.SubGroupCount != 2 {
+		t.Errorf("expected 2 subgroups, got %v", group.SubGroupCount)
+	}
+
+	body, err := json.Marshal(&group)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var representation map[string]interface{}
+	err = json.Unmarshal(body, &representation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := representation["parentId"]; ok {
+		t.Errorf("expected parentId not to be serialized, got %s", body)
+	}
+}
+
+func TestGetGroupByDFS(t *testing.T) {
+	var groups []*Group
+	err := json.Unmarshal([]byte(`[
+		{"id": "a", "name": "a", "subGroups": [
+			{"id": "a-b", "name": "b", "subGroups": [
+				{"id": "a-b-c", "name": "c"}
+			]}
+		]},
+		{"id": "c", "name": "c"},
+		{"id": "e", "name": "e", "subGroupCount": 1}
+	]`), &groups)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// only the subgroups of e are left out, like Keycloak 23 and later may do
+	var fetched []string
+	children := func(group *Group) ([]*Group, error) {
+		fetched = append(fetched, group.Id)
+		return []*Group{{Id: "e-f", Name: "f"}}, nil
+	}
+
+	tests := map[string]struct {
+		expectedId       string
+		expectedParentId string
+	}{
+		"a": {"a", ""},
+		"b": {"a-b", "a"},
+		"c": {"a-b-c", "a-b"},
+		"d": {"", ""},
+		"f": {"e-f", "e"},
+	}
+
+	for name, test := range tests {
+		group, parentId, err := getGroupByDFS(name, groups, "", children)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		groupId := ""
+		if group != nil {
+			groupId = group.Id
+		}
+
+		if groupId != test.expectedId || parentId != test.expectedParentId {
+			t.Errorf("%s: expected group %q with parent %q, got %q with parent %q", name, test.expectedId, test.expectedParentId, groupId, parentId)
+		}
+	}
+
+	if len(fetched) != 2 || fetched[0] != "e" || fetched[1] != "e" {
+		t.Errorf("expected only the children of e to be fetched, for d and f, got %v", fetched)
+	}
+}
+
+func TestGetGroupByDFSFetchedChildren(t *testing.T) {
+	subGroupCount := 3
+	groups := []*Group{{Id: "e", Name: "e", SubGroupCount: &subGroupCount}}
+
+	// the fetched children have subgroups of their own, which are never fetched
+	var fetched []string
+	children := func(group *Group) ([]*Group, error) {
+		fetched = append(fetched, group.Id)
+		return []*Group{
+			{Id: "e-f", Name: "f", SubGroupCount: &subGroupCount},
+			{Id: "e-g", Name: "g", SubGroupCount: &subGroupCount},
+		}, nil
+	}
+
+	group, _, err := getGroupByDFS("h", groups, "", children)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if group != nil {
+		t.Errorf("expected no group, got %q", group.Id)
+	}
+
+	if len(fetched) != 1 || fetched[0] != "e" {
+		t.Errorf("expected only the children of e to be fetched, got %v", fetched)
+	}
+}
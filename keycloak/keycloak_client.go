@@ -555,6 +555,27 @@ func (keycloakClient *KeycloakClient) get(ctx context.Context, path string, reso
 }
 
 func (keycloakClient *KeycloakClient) getRaw(ctx context.Context, path string, params map[string]string) ([]byte, error) {
+	var query url.Values
+	if params != nil {
+		query = url.Values{}
+		for k, v := range params {
+			query.Add(k, v)
+		}
+	}
+
+	return keycloakClient.getRawWithQuery(ctx, path, query)
+}
+
+// getWithQuery is like get, but supports query parameters with multiple values
+func (keycloakClient *KeycloakClient) getWithQuery(ctx context.Context, path string, resource interface{}, query url.Values) error {
+	body, err := keycloakClient.getRawWithQuery(ctx, path, query)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, resource)
+}
+
+func (keycloakClient *KeycloakClient) getRawWithQuery(ctx context.Context, path string, query url.Values) ([]byte, error) {
 	resourceUrl := keycloakClient.baseUrl + apiUrl + path
 
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, resourceUrl, nil)
@@ -562,11 +583,7 @@ func (keycloakClient *KeycloakClient) getRaw(ctx context.Context, path string, p
 		return nil, err
 	}
 
-	if params != nil {
-		query := url.Values{}
-		for k, v := range params {
-			query.Add(k, v)
-		}
+	if query != nil {
 		request.URL.RawQuery = query.Encode()
 	}
 
@@ -0,0 +1,73 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// GlobalRequestResult is returned by the endpoints that push a revocation or a logout to the admin URLs of the clients
+type GlobalRequestResult struct {
+	SuccessRequests []string `json:"successRequests"`
+	FailedRequests  []string `json:"failedRequests"`
+}
+
+func (keycloakClient *KeycloakClient) postGlobalRequest(ctx context.Context, path string) (*GlobalRequestResult, error) {
+	body, _, err := keycloakClient.post(ctx, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result GlobalRequestResult
+	if len(body) != 0 {
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &result, nil
+}
+
+// setNotBefore updates the notBefore field of the representation at the given path. The representation is read and
+// written back as a whole so that none of the attributes the provider does not model are lost.
+func (keycloakClient *KeycloakClient) setNotBefore(ctx context.Context, path string, notBefore int) error {
+	var representation map[string]interface{}
+
+	err := keycloakClient.get(ctx, path, &representation, nil)
+	if err != nil {
+		return err
+	}
+
+	representation["notBefore"] = notBefore
+
+	return keycloakClient.put(ctx, path, representation)
+}
+
+func (keycloakClient *KeycloakClient) SetRealmNotBefore(ctx context.Context, realmId string, notBefore int) error {
+	return keycloakClient.setNotBefore(ctx, fmt.Sprintf("/realms/%s", realmId), notBefore)
+}
+
+func (keycloakClient *KeycloakClient) PushRealmRevocation(ctx context.Context, realmId string) (*GlobalRequestResult, error) {
+	return keycloakClient.postGlobalRequest(ctx, fmt.Sprintf("/realms/%s/push-revocation", realmId))
+}
+
+// LogoutAllRealmSessions removes every user session of the realm, sets the realm's not-before policy to the current time
+// and pushes the new policy to all clients with an admin URL
+func (keycloakClient *KeycloakClient) LogoutAllRealmSessions(ctx context.Context, realmId string) (*GlobalRequestResult, error) {
+	return keycloakClient.postGlobalRequest(ctx, fmt.Sprintf("/realms/%s/logout-all", realmId))
+}
+
+func (keycloakClient *KeycloakClient) SetClientNotBefore(ctx context.Context, realmId, id string, notBefore int) error {
+	return keycloakClient.setNotBefore(ctx, fmt.Sprintf("/realms/%s/clients/%s", realmId, id), notBefore)
+}
+
+func (keycloakClient *KeycloakClient) PushClientRevocation(ctx context.Context, realmId, id string) (*GlobalRequestResult, error) {
+	return keycloakClient.postGlobalRequest(ctx, fmt.Sprintf("/realms/%s/clients/%s/push-revocation", realmId, id))
+}
+
+// LogoutUser removes all sessions of the user and invalidates the tokens issued before now
+func (keycloakClient *KeycloakClient) LogoutUser(ctx context.Context, realmId, userId string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/logout", realmId, userId), nil)
+	return err
+}
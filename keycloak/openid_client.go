@@ -89,6 +89,12 @@ type OpenidClientAttributes struct {
 	PostLogoutRedirectUris                   types.KeycloakSliceHashDelimited `json:"post.logout.redirect.uris,omitempty"`
 	StandardTokenExchangeEnabled             types.KeycloakBoolQuoted         `json:"standard.token.exchange.enabled,omitempty"`
 	AllowRefreshTokenInStandardTokenExchange string                           `json:"standard.token.exchange.enableRefreshRequestedTokenType,omitempty"`
+	CibaGrantEnabled                         types.KeycloakBoolQuoted         `json:"oidc.ciba.grant.enabled"`
+	CibaBackchannelTokenDeliveryMode         string                           `json:"ciba.backchannel.token.delivery.mode,omitempty"`
+	CibaClientNotificationEndpoint           string                           `json:"ciba.backchannel.client.notification.endpoint,omitempty"`
+	CibaBackchannelAuthRequestSigningAlg     string                           `json:"ciba.backchannel.auth.request.signing.alg,omitempty"`
+	RequirePushedAuthorizationRequests       types.KeycloakBoolQuoted         `json:"require.pushed.authorization.requests"`
+	RequestObjectRequired                    string                           `json:"request.object.required,omitempty"`
 }
 
 type OpenidAuthenticationFlowBindingOverrides struct {
@@ -135,6 +141,26 @@ func (keycloakClient *KeycloakClient) ValidateOpenidClient(ctx context.Context,
 		return fmt.Errorf("validation error: standard token exchange cannot be enabled on public clients")
 	}
 
+	if client.Attributes.CibaGrantEnabled {
+		if client.PublicClient {
+			return fmt.Errorf("validation error: the client initiated backchannel authentication grant cannot be enabled on public clients")
+		}
+
+		if ok, _ := keycloakClient.VersionIsLessThan(ctx, Version_12); ok {
+			return fmt.Errorf("validation error: the client initiated backchannel authentication grant requires Keycloak 12 or higher")
+		}
+	}
+
+	if client.Attributes.CibaBackchannelTokenDeliveryMode == "ping" && client.Attributes.CibaClientNotificationEndpoint == "" {
+		return fmt.Errorf("validation error: a client notification endpoint is required when the backchannel token delivery mode is ping")
+	}
+
+	if client.Attributes.RequirePushedAuthorizationRequests {
+		if ok, _ := keycloakClient.VersionIsLessThan(ctx, Version_13); ok {
+			return fmt.Errorf("validation error: requiring pushed authorization requests requires Keycloak 13 or higher")
+		}
+	}
+
 	return nil
 }
 
@@ -3,7 +3,7 @@ package keycloak
 import (
 	"context"
 	"fmt"
-	"strings"
+	"strconv"
 
 	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
 )
@@ -17,6 +17,7 @@ type Key struct {
 	Kid              *string `json:"kid,omitempty"`
 	Status           *string `json:"status,omitempty"`
 	Type             *string `json:"type,omitempty"`
+	Use              *string `json:"use,omitempty"`
 }
 
 type Keys struct {
@@ -31,6 +32,7 @@ type Realm struct {
 	DisplayNameHtml      string `json:"displayNameHtml"`
 	UserManagedAccess    bool   `json:"userManagedAccessAllowed"`
 	OrganizationsEnabled bool   `json:"organizationsEnabled,omitempty"`
+	NotBefore            int    `json:"notBefore,omitempty"` // only changed through SetRealmNotBefore and LogoutAllRealmSessions
 
 	// Login Config
 	RegistrationAllowed         bool   `json:"registrationAllowed"`
@@ -192,6 +194,67 @@ type SmtpServer struct {
 	AuthTokenScope        string                   `json:"authTokenScope,omitempty"`
 }
 
+// Realm attributes that hold the CIBA and PAR policies of a realm
+const (
+	RealmAttributeCibaBackchannelTokenDeliveryMode = "cibaBackchannelTokenDeliveryMode"
+	RealmAttributeCibaExpiresIn                    = "cibaExpiresIn"
+	RealmAttributeCibaInterval                     = "cibaInterval"
+	RealmAttributeCibaAuthRequestedUserHint        = "cibaAuthRequestedUserHint"
+	RealmAttributeParRequestUriLifespan            = "parRequestUriLifespan"
+)
+
+type RealmCibaPolicy struct {
+	BackchannelTokenDeliveryMode string
+	ExpiresIn                    int
+	Interval                     int
+	AuthRequestedUserHint        string
+}
+
+// GetCibaPolicy returns the CIBA policy stored in the realm attributes, or nil when the server doesn't expose one
+func (realm *Realm) GetCibaPolicy() *RealmCibaPolicy {
+	deliveryMode, ok := realm.getStringAttribute(RealmAttributeCibaBackchannelTokenDeliveryMode)
+	if !ok {
+		return nil
+	}
+
+	cibaPolicy := &RealmCibaPolicy{
+		BackchannelTokenDeliveryMode: deliveryMode,
+	}
+	cibaPolicy.ExpiresIn, _ = realm.getIntAttribute(RealmAttributeCibaExpiresIn)
+	cibaPolicy.Interval, _ = realm.getIntAttribute(RealmAttributeCibaInterval)
+	cibaPolicy.AuthRequestedUserHint, _ = realm.getStringAttribute(RealmAttributeCibaAuthRequestedUserHint)
+
+	return cibaPolicy
+}
+
+// GetParRequestUriLifespan returns the lifespan of the request URIs issued for pushed authorization requests, in seconds
+func (realm *Realm) GetParRequestUriLifespan() (int, bool) {
+	return realm.getIntAttribute(RealmAttributeParRequestUriLifespan)
+}
+
+func (realm *Realm) getStringAttribute(key string) (string, bool) {
+	value, ok := realm.Attributes[key]
+	if !ok || value == nil {
+		return "", false
+	}
+
+	return fmt.Sprintf("%v", value), true
+}
+
+func (realm *Realm) getIntAttribute(key string) (int, bool) {
+	value, ok := realm.getStringAttribute(key)
+	if !ok {
+		return 0, false
+	}
+
+	intValue, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return intValue, true
+}
+
 func (keycloakClient *KeycloakClient) NewRealm(ctx context.Context, realm *Realm) error {
 	_, _, err := keycloakClient.post(ctx, "/realms", realm)
 
@@ -284,12 +347,9 @@ func (keycloakClient *KeycloakClient) ValidateRealm(ctx context.Context, realm *
 	}
 
 	if realm.PasswordPolicy != "" {
-		policies := strings.Split(realm.PasswordPolicy, " and ")
-		for _, policyTypeRepresentation := range policies {
-			policy := strings.Split(policyTypeRepresentation, "(")
-			if !serverInfo.providerInstalled("password-policy", policy[0]) {
-				return fmt.Errorf("validation error: password-policy \"%s\" does not exist on the server, installed providers: %s", policy[0], serverInfo.getInstalledProvidersNames("password-policy"))
-			}
+		err = serverInfo.ValidatePasswordPolicy(ParsePasswordPolicy(realm.PasswordPolicy))
+		if err != nil {
+			return err
 		}
 	}
 
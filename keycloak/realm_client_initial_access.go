@@ -0,0 +1,87 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type RealmClientInitialAccess struct {
+	Id             string `json:"id,omitempty"`
+	RealmId        string `json:"-"`
+	Token          string `json:"token,omitempty"` // only returned when the token is created
+	Timestamp      int64  `json:"timestamp,omitempty"`
+	Expiration     int    `json:"expiration"`
+	Count          int    `json:"count"`
+	RemainingCount int    `json:"remainingCount,omitempty"`
+}
+
+// ExpiresAt returns the time the token expires, or the zero time if the token never expires
+func (initialAccess *RealmClientInitialAccess) ExpiresAt() time.Time {
+	if initialAccess.Expiration == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(initialAccess.Timestamp+int64(initialAccess.Expiration), 0)
+}
+
+func (initialAccess *RealmClientInitialAccess) IsExpired() bool {
+	expiresAt := initialAccess.ExpiresAt()
+
+	return !expiresAt.IsZero() && !time.Now().Before(expiresAt)
+}
+
+func (keycloakClient *KeycloakClient) NewRealmClientInitialAccess(ctx context.Context, initialAccess *RealmClientInitialAccess) error {
+	body, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/clients-initial-access", initialAccess.RealmId), initialAccess)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, initialAccess)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmClientInitialAccesses(ctx context.Context, realmId string) ([]*RealmClientInitialAccess, error) {
+	var initialAccesses []*RealmClientInitialAccess
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/clients-initial-access", realmId), &initialAccesses, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, initialAccess := range initialAccesses {
+		initialAccess.RealmId = realmId
+	}
+
+	return initialAccesses, nil
+}
+
+// GetRealmClientInitialAccess returns the initial access token with the given id. Keycloak has no endpoint to fetch a single
+// token, so the list is searched instead. A 404 error is returned if the token does not exist anymore, which is the case
+// once it has been used up or expired.
+func (keycloakClient *KeycloakClient) GetRealmClientInitialAccess(ctx context.Context, realmId, id string) (*RealmClientInitialAccess, error) {
+	initialAccesses, err := keycloakClient.GetRealmClientInitialAccesses(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, initialAccess := range initialAccesses {
+		if initialAccess.Id == id {
+			return initialAccess, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    404,
+		Message: fmt.Sprintf("client initial access token %s does not exist in realm %s", id, realmId),
+	}
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmClientInitialAccess(ctx context.Context, realmId, id string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/clients-initial-access/%s", realmId, id), nil)
+}
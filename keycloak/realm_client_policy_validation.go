@@ -0,0 +1,109 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	clientPolicyExecutorComponentType  = "org.keycloak.services.clientpolicy.executor.ClientPolicyExecutorProvider"
+	clientPolicyConditionComponentType = "org.keycloak.services.clientpolicy.condition.ClientPolicyConditionProvider"
+)
+
+// ValidateClientPolicyExecutor checks that a client policy executor is installed on the server and that its configuration
+// matches the property metadata the executor provider exposes. Configuration values are expected in the string form
+// used by the provider schema, with JSON arrays for multivalued properties.
+func (serverInfo *ServerInfo) ValidateClientPolicyExecutor(name string, configuration map[string]string) error {
+	return serverInfo.validateClientPolicyComponent(clientPolicyExecutorComponentType, "executor", name, configuration)
+}
+
+// ValidateClientPolicyCondition is the condition counterpart of ValidateClientPolicyExecutor.
+func (serverInfo *ServerInfo) ValidateClientPolicyCondition(name string, configuration map[string]string) error {
+	return serverInfo.validateClientPolicyComponent(clientPolicyConditionComponentType, "condition", name, configuration)
+}
+
+func (serverInfo *ServerInfo) validateClientPolicyComponent(componentType, kind, name string, configuration map[string]string) error {
+	componentTypes, ok := serverInfo.ComponentTypes[componentType]
+	if !ok {
+		// servers that do not publish client policy metadata can't be validated
+		return nil
+	}
+
+	var installed []string
+	var component *ComponentType
+	for i, ct := range componentTypes {
+		installed = append(installed, ct.Id)
+		if ct.Id == name {
+			component = &componentTypes[i]
+		}
+	}
+
+	if component == nil {
+		sort.Strings(installed)
+		return fmt.Errorf("validation error: client policy %s \"%s\" does not exist on the server, installed %ss: %s", kind, name, kind, strings.Join(installed, ", "))
+	}
+
+	properties := make(map[string]ComponentTypeProperty, len(component.Properties))
+	var names []string
+	for _, property := range component.Properties {
+		properties[property.Name] = property
+		names = append(names, property.Name)
+	}
+	sort.Strings(names)
+
+	var keys []string
+	for key := range configuration {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		property, ok := properties[key]
+		if !ok {
+			return fmt.Errorf("validation error: configuration key \"%s\" is not supported by client policy %s \"%s\", supported keys: %s", key, kind, name, strings.Join(names, ", "))
+		}
+
+		err := validateComponentTypePropertyValue(property, configuration[key])
+		if err != nil {
+			return fmt.Errorf("validation error: configuration key \"%s\" of client policy %s \"%s\" %s", key, kind, name, err)
+		}
+	}
+
+	return nil
+}
+
+func validateComponentTypePropertyValue(property ComponentTypeProperty, value string) error {
+	switch property.Type {
+	case "boolean":
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("must be a boolean, got \"%s\"", value)
+		}
+	case "int", "Integer":
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("must be an integer, got \"%s\"", value)
+		}
+	case "List":
+		if len(property.Options) != 0 && !slices.Contains(property.Options, value) {
+			return fmt.Errorf("must be one of %s, got \"%s\"", strings.Join(property.Options, ", "), value)
+		}
+	case "MultivaluedList", "MultivaluedString":
+		var values []string
+		if err := json.Unmarshal([]byte(value), &values); err != nil {
+			return fmt.Errorf("must be a JSON encoded list of strings, got \"%s\"", value)
+		}
+
+		if property.Type == "MultivaluedList" && len(property.Options) != 0 {
+			for _, v := range values {
+				if !slices.Contains(property.Options, v) {
+					return fmt.Errorf("must only contain values of %s, got \"%s\"", strings.Join(property.Options, ", "), v)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package keycloak
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestServerInfoValidateClientPolicyExecutor(t *testing.T) {
+	serverInfo := &ServerInfo{
+		ComponentTypes: map[string][]ComponentType{
+			clientPolicyExecutorComponentType: {
+				{
+					Id: "secure-client-authenticator",
+					Properties: []ComponentTypeProperty{
+						{Name: "allowed-client-authenticators", Type: "MultivaluedList", Options: []string{"client-secret", "client-jwt"}},
+						{Name: "default-client-authenticator", Type: "List", Options: []string{"client-secret", "client-jwt"}},
+					},
+				},
+				{
+					Id:         "pkce-enforcer",
+					Properties: []ComponentTypeProperty{{Name: "auto-configure", Type: "boolean"}},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		executor      string
+		configuration map[string]string
+		err           string
+	}{
+		{name: "valid", executor: "secure-client-authenticator", configuration: map[string]string{"allowed-client-authenticators": `["client-jwt"]`, "default-client-authenticator": "client-jwt"}},
+		{name: "unknown executor", executor: "pkce-enforcers", err: "installed executors: pkce-enforcer, secure-client-authenticator"},
+		{name: "unknown key", executor: "pkce-enforcer", configuration: map[string]string{"auto_configure": "true"}, err: "supported keys: auto-configure"},
+		{name: "invalid boolean", executor: "pkce-enforcer", configuration: map[string]string{"auto-configure": "maybe"}, err: "must be a boolean"},
+		{name: "invalid option", executor: "secure-client-authenticator", configuration: map[string]string{"default-client-authenticator": "client-x509"}, err: "must be one of client-secret, client-jwt"},
+		{name: "not a list", executor: "secure-client-authenticator", configuration: map[string]string{"allowed-client-authenticators": "client-jwt"}, err: "must be a JSON encoded list of strings"},
+		{name: "invalid list option", executor: "secure-client-authenticator", configuration: map[string]string{"allowed-client-authenticators": `["client-x509"]`}, err: "must only contain values of client-secret, client-jwt"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := serverInfo.ValidateClientPolicyExecutor(test.executor, test.configuration)
+			if test.err == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("expected error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestServerInfoValidateClientPolicyConditionWithoutMetadata(t *testing.T) {
+	serverInfo := &ServerInfo{}
+
+	if err := serverInfo.ValidateClientPolicyCondition("client-roles", map[string]string{"anything": "goes"}); err != nil {
+		t.Fatalf("expected no error without client policy metadata, got %s", err)
+	}
+}
@@ -3,6 +3,8 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
 )
 
 type RealmEventsConfig struct {
@@ -28,3 +30,159 @@ func (keycloakClient *KeycloakClient) GetRealmEventsConfig(ctx context.Context,
 func (keycloakClient *KeycloakClient) UpdateRealmEventsConfig(ctx context.Context, realmId string, realmEventsConfig *RealmEventsConfig) error {
 	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/events/config", realmId), realmEventsConfig)
 }
+
+type Event struct {
+	Id        string            `json:"id,omitempty"`
+	Time      int64             `json:"time"`
+	Type      string            `json:"type"`
+	RealmId   string            `json:"realmId"`
+	ClientId  string            `json:"clientId"`
+	UserId    string            `json:"userId"`
+	SessionId string            `json:"sessionId"`
+	IpAddress string            `json:"ipAddress"`
+	Error     string            `json:"error"`
+	Details   map[string]string `json:"details"`
+}
+
+type AdminEventAuthDetails struct {
+	RealmId   string `json:"realmId"`
+	ClientId  string `json:"clientId"`
+	UserId    string `json:"userId"`
+	IpAddress string `json:"ipAddress"`
+}
+
+type AdminEvent struct {
+	Id             string                `json:"id,omitempty"`
+	Time           int64                 `json:"time"`
+	RealmId        string                `json:"realmId"`
+	AuthDetails    AdminEventAuthDetails `json:"authDetails"`
+	OperationType  string                `json:"operationType"`
+	ResourceType   string                `json:"resourceType"`
+	ResourcePath   string                `json:"resourcePath"`
+	Representation string                `json:"representation"`
+	Error          string                `json:"error"`
+}
+
+// EventsQuery holds the filters of the events endpoint, empty filters are not sent
+type EventsQuery struct {
+	Types     []string
+	Client    string
+	User      string
+	IpAddress string
+	DateFrom  string
+	DateTo    string
+}
+
+// AdminEventsQuery holds the filters of the admin events endpoint, empty filters are not sent
+type AdminEventsQuery struct {
+	OperationTypes []string
+	ResourceTypes  []string
+	ResourcePath   string
+	AuthRealm      string
+	AuthClient     string
+	AuthUser       string
+	AuthIpAddress  string
+	DateFrom       string
+	DateTo         string
+}
+
+const eventsMaxPageSize = 100
+
+func addQueryParam(query url.Values, key, value string) {
+	if value != "" {
+		query.Add(key, value)
+	}
+}
+
+func (query *EventsQuery) values() url.Values {
+	values := url.Values{}
+	for _, eventType := range query.Types {
+		values.Add("type", eventType)
+	}
+	addQueryParam(values, "client", query.Client)
+	addQueryParam(values, "user", query.User)
+	addQueryParam(values, "ipAddress", query.IpAddress)
+	addQueryParam(values, "dateFrom", query.DateFrom)
+	addQueryParam(values, "dateTo", query.DateTo)
+
+	return values
+}
+
+func (query *AdminEventsQuery) values() url.Values {
+	values := url.Values{}
+	for _, operationType := range query.OperationTypes {
+		values.Add("operationTypes", operationType)
+	}
+	for _, resourceType := range query.ResourceTypes {
+		values.Add("resourceTypes", resourceType)
+	}
+	addQueryParam(values, "resourcePath", query.ResourcePath)
+	addQueryParam(values, "authRealm", query.AuthRealm)
+	addQueryParam(values, "authClient", query.AuthClient)
+	addQueryParam(values, "authUser", query.AuthUser)
+	addQueryParam(values, "authIpAddress", query.AuthIpAddress)
+	addQueryParam(values, "dateFrom", query.DateFrom)
+	addQueryParam(values, "dateTo", query.DateTo)
+
+	return values
+}
+
+// eventsPageSize returns the size of the next page to fetch from an events endpoint, or 0 once maxResults results were
+// fetched. A maxResults of 0 fetches all results.
+func eventsPageSize(fetched, maxResults int) int {
+	if maxResults > 0 && maxResults-fetched < eventsMaxPageSize {
+		return maxResults - fetched
+	}
+
+	return eventsMaxPageSize
+}
+
+// GetRealmEvents returns the stored login events of a realm that match the query, newest first
+func (keycloakClient *KeycloakClient) GetRealmEvents(ctx context.Context, realmId string, query *EventsQuery, maxResults int) ([]*Event, error) {
+	var events []*Event
+
+	values := query.values()
+	for pageSize := eventsPageSize(0, maxResults); pageSize > 0; pageSize = eventsPageSize(len(events), maxResults) {
+		values.Set("first", strconv.Itoa(len(events)))
+		values.Set("max", strconv.Itoa(pageSize))
+
+		var page []*Event
+		err := keycloakClient.getWithQuery(ctx, fmt.Sprintf("/realms/%s/events", realmId), &page, values)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, page...)
+
+		if len(page) < pageSize {
+			break
+		}
+	}
+
+	return events, nil
+}
+
+// GetRealmAdminEvents returns the stored admin events of a realm that match the query, newest first
+func (keycloakClient *KeycloakClient) GetRealmAdminEvents(ctx context.Context, realmId string, query *AdminEventsQuery, maxResults int) ([]*AdminEvent, error) {
+	var adminEvents []*AdminEvent
+
+	values := query.values()
+	for pageSize := eventsPageSize(0, maxResults); pageSize > 0; pageSize = eventsPageSize(len(adminEvents), maxResults) {
+		values.Set("first", strconv.Itoa(len(adminEvents)))
+		values.Set("max", strconv.Itoa(pageSize))
+
+		var page []*AdminEvent
+		err := keycloakClient.getWithQuery(ctx, fmt.Sprintf("/realms/%s/admin-events", realmId), &page, values)
+		if err != nil {
+			return nil, err
+		}
+
+		adminEvents = append(adminEvents, page...)
+
+		if len(page) < pageSize {
+			break
+		}
+	}
+
+	return adminEvents, nil
+}
@@ -0,0 +1,129 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// RealmGeneratedKeyProvider is a key provider component of any of the "*-generated" provider types. It is used by the
+// key rotation, which needs to handle all of them the same way. Config holds the provider specific settings, such as
+// the key size or the elliptic curve.
+type RealmGeneratedKeyProvider struct {
+	Id         string
+	Name       string
+	RealmId    string
+	ProviderId string
+
+	Active   bool
+	Enabled  bool
+	Priority int
+	Config   map[string]string
+}
+
+func convertFromRealmGeneratedKeyProviderToComponent(keyProvider *RealmGeneratedKeyProvider) *component {
+	componentConfig := map[string][]string{}
+	for key, value := range keyProvider.Config {
+		componentConfig[key] = []string{value}
+	}
+
+	componentConfig["active"] = []string{strconv.FormatBool(keyProvider.Active)}
+	componentConfig["enabled"] = []string{strconv.FormatBool(keyProvider.Enabled)}
+	componentConfig["priority"] = []string{strconv.Itoa(keyProvider.Priority)}
+
+	return &component{
+		Id:           keyProvider.Id,
+		Name:         keyProvider.Name,
+		ParentId:     keyProvider.RealmId,
+		ProviderId:   keyProvider.ProviderId,
+		ProviderType: "org.keycloak.keys.KeyProvider",
+		Config:       componentConfig,
+	}
+}
+
+func convertFromComponentToRealmGeneratedKeyProvider(component *component, realmId string) (*RealmGeneratedKeyProvider, error) {
+	active, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("active"))
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := parseBoolAndTreatEmptyStringAsFalse(component.getConfig("enabled"))
+	if err != nil {
+		return nil, err
+	}
+
+	priority, err := atoiAndTreatEmptyStringAsZero(component.getConfig("priority"))
+	if err != nil {
+		return nil, err
+	}
+
+	config := map[string]string{}
+	for key := range component.Config {
+		if key == "active" || key == "enabled" || key == "priority" {
+			continue
+		}
+
+		config[key] = component.getConfig(key)
+	}
+
+	return &RealmGeneratedKeyProvider{
+		Id:         component.Id,
+		Name:       component.Name,
+		RealmId:    realmId,
+		ProviderId: component.ProviderId,
+
+		Active:   active,
+		Enabled:  enabled,
+		Priority: priority,
+		Config:   config,
+	}, nil
+}
+
+func (keycloakClient *KeycloakClient) NewRealmGeneratedKeyProvider(ctx context.Context, keyProvider *RealmGeneratedKeyProvider) error {
+	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/components", keyProvider.RealmId), convertFromRealmGeneratedKeyProviderToComponent(keyProvider))
+	if err != nil {
+		return err
+	}
+
+	keyProvider.Id = getIdFromLocationHeader(location)
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRealmGeneratedKeyProvider(ctx context.Context, realmId, id string) (*RealmGeneratedKeyProvider, error) {
+	var component *component
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/components/%s", realmId, id), &component, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertFromComponentToRealmGeneratedKeyProvider(component, realmId)
+}
+
+// UpdateRealmGeneratedKeyProvider writes the provider back including the config returned by GetRealmGeneratedKeyProvider.
+// Keycloak masks the generated secrets in that config and keeps the stored values for masked entries, so the keys are not regenerated.
+func (keycloakClient *KeycloakClient) UpdateRealmGeneratedKeyProvider(ctx context.Context, keyProvider *RealmGeneratedKeyProvider) error {
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/components/%s", keyProvider.RealmId, keyProvider.Id), convertFromRealmGeneratedKeyProviderToComponent(keyProvider))
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmGeneratedKeyProvider(ctx context.Context, realmId, id string) error {
+	return keycloakClient.DeleteComponent(ctx, realmId, id)
+}
+
+// GetRealmKeysForProvider returns the keys of the realm that were created by the key provider with the given component id
+func (keycloakClient *KeycloakClient) GetRealmKeysForProvider(ctx context.Context, realmId, id string) ([]Key, error) {
+	keys, err := keycloakClient.GetRealmKeys(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	var providerKeys []Key
+	for _, key := range keys.Keys {
+		if key.ProviderId != nil && *key.ProviderId == id {
+			providerKeys = append(providerKeys, key)
+		}
+	}
+
+	return providerKeys, nil
+}
@@ -0,0 +1,137 @@
+package keycloak
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// JSONWebKey is the public part of a realm key, as described in RFC 7517
+type JSONWebKey struct {
+	Kid string   `json:"kid"`
+	Kty string   `json:"kty"`
+	Use string   `json:"use,omitempty"`
+	Alg string   `json:"alg,omitempty"`
+	Crv string   `json:"crv,omitempty"`
+	N   string   `json:"n,omitempty"`
+	E   string   `json:"e,omitempty"`
+	X   string   `json:"x,omitempty"`
+	Y   string   `json:"y,omitempty"`
+	X5c []string `json:"x5c,omitempty"`
+}
+
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// Ed448 keys can't be parsed by crypto/x509, their public key is taken from the raw SubjectPublicKeyInfo instead
+var oidPublicKeyEd448 = asn1.ObjectIdentifier{1, 3, 101, 113}
+
+type subjectPublicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+func base64UrlEncode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// JSONWebKey converts the base64 encoded public key of a realm key to a JSON web key. Secret keys, like the ones of the
+// hmac-generated and aes-generated providers, have no public key, nil is returned for them.
+func (key Key) JSONWebKey() (*JSONWebKey, error) {
+	if key.PublicKey == nil || *key.PublicKey == "" {
+		return nil, nil
+	}
+
+	jwk := &JSONWebKey{}
+	if key.Kid != nil {
+		jwk.Kid = *key.Kid
+	}
+	if key.Use != nil {
+		jwk.Use = strings.ToLower(*key.Use)
+	}
+	if key.Algorithm != nil {
+		jwk.Alg = *key.Algorithm
+	}
+	if key.Certificate != nil && *key.Certificate != "" {
+		jwk.X5c = []string{*key.Certificate}
+	}
+
+	der, err := base64.StdEncoding.DecodeString(*key.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode public key of key %s: %v", jwk.Kid, err)
+	}
+
+	var spki subjectPublicKeyInfo
+	if _, err := asn1.Unmarshal(der, &spki); err != nil {
+		return nil, fmt.Errorf("could not parse public key of key %s: %v", jwk.Kid, err)
+	}
+
+	if spki.Algorithm.Algorithm.Equal(oidPublicKeyEd448) {
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed448"
+		jwk.X = base64UrlEncode(spki.PublicKey.Bytes)
+
+		return jwk, nil
+	}
+
+	parsedPublicKey, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse public key of key %s: %v", jwk.Kid, err)
+	}
+
+	switch pk := parsedPublicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64UrlEncode(pk.N.Bytes())
+		jwk.E = base64UrlEncode(big.NewInt(int64(pk.E)).Bytes())
+	case *ecdsa.PublicKey:
+		// the uncompressed point is 0x04 followed by both coordinates, each with the byte size of the curve
+		point, err := pk.Bytes()
+		if err != nil {
+			return nil, fmt.Errorf("could not encode public key of key %s: %v", jwk.Kid, err)
+		}
+
+		size := (len(point) - 1) / 2
+
+		jwk.Kty = "EC"
+		jwk.Crv = pk.Curve.Params().Name
+		jwk.X = base64UrlEncode(point[1 : 1+size])
+		jwk.Y = base64UrlEncode(point[1+size:])
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64UrlEncode(pk)
+	default:
+		return nil, fmt.Errorf("public key of key %s has unsupported type %T", jwk.Kid, parsedPublicKey)
+	}
+
+	return jwk, nil
+}
+
+// NewJSONWebKeySet builds a JSON web key set from the keys that have a public key
+func NewJSONWebKeySet(keys []Key) (*JSONWebKeySet, error) {
+	jwks := &JSONWebKeySet{
+		Keys: []JSONWebKey{},
+	}
+
+	for _, key := range keys {
+		jwk, err := key.JSONWebKey()
+		if err != nil {
+			return nil, err
+		}
+
+		if jwk != nil {
+			jwks.Keys = append(jwks.Keys, *jwk)
+		}
+	}
+
+	return jwks, nil
+}
@@ -0,0 +1,147 @@
+package keycloak
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"testing"
+)
+
+func testRealmKey(t *testing.T, publicKey interface{}, algorithm, use string) Key {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return testRealmKeyFromDer(der, algorithm, use)
+}
+
+func testRealmKeyFromDer(der []byte, algorithm, use string) Key {
+	kid := "test-kid"
+	encodedPublicKey := base64.StdEncoding.EncodeToString(der)
+
+	return Key{
+		Kid:       &kid,
+		Algorithm: &algorithm,
+		Use:       &use,
+		PublicKey: &encodedPublicKey,
+	}
+}
+
+func TestJSONWebKeyRsa(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := testRealmKey(t, &privateKey.PublicKey, "RSA-OAEP", "ENC")
+	certificate := "MIIC"
+	key.Certificate = &certificate
+
+	jwk, err := key.JSONWebKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if jwk.Kty != "RSA" || jwk.Use != "enc" || jwk.Alg != "RSA-OAEP" || jwk.Kid != "test-kid" {
+		t.Fatalf("unexpected JSON web key %+v", jwk)
+	}
+
+	if jwk.E != "AQAB" || jwk.N != base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()) {
+		t.Fatalf("unexpected RSA parameters n=%s e=%s", jwk.N, jwk.E)
+	}
+
+	if len(jwk.X5c) != 1 || jwk.X5c[0] != certificate {
+		t.Fatalf("expected x5c to contain the certificate, got %v", jwk.X5c)
+	}
+}
+
+func TestJSONWebKeyEcdsa(t *testing.T) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwk, err := testRealmKey(t, &privateKey.PublicKey, "ES384", "SIG").JSONWebKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if jwk.Kty != "EC" || jwk.Crv != "P-384" || jwk.Use != "sig" {
+		t.Fatalf("unexpected JSON web key %+v", jwk)
+	}
+
+	x, _ := base64.RawURLEncoding.DecodeString(jwk.X)
+	y, _ := base64.RawURLEncoding.DecodeString(jwk.Y)
+	if len(x) != 48 || len(y) != 48 {
+		t.Fatalf("expected coordinates of 48 bytes, got %d and %d", len(x), len(y))
+	}
+}
+
+func TestJSONWebKeyEd25519(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwk, err := testRealmKey(t, publicKey, "EdDSA", "SIG").JSONWebKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if jwk.Kty != "OKP" || jwk.Crv != "Ed25519" || jwk.X != base64.RawURLEncoding.EncodeToString(publicKey) {
+		t.Fatalf("unexpected JSON web key %+v", jwk)
+	}
+}
+
+func TestJSONWebKeyEd448(t *testing.T) {
+	publicKey := make([]byte, 57)
+	if _, err := rand.Read(publicKey); err != nil {
+		t.Fatal(err)
+	}
+
+	der, err := asn1.Marshal(subjectPublicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyEd448},
+		PublicKey: asn1.BitString{Bytes: publicKey, BitLength: len(publicKey) * 8},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jwk, err := testRealmKeyFromDer(der, "EdDSA", "SIG").JSONWebKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if jwk.Kty != "OKP" || jwk.Crv != "Ed448" || jwk.X != base64.RawURLEncoding.EncodeToString(publicKey) {
+		t.Fatalf("unexpected JSON web key %+v", jwk)
+	}
+}
+
+func TestJSONWebKeySetSkipsSecretKeys(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secretKid := "secret"
+	secretType := "OCT"
+
+	jwks, err := NewJSONWebKeySet([]Key{
+		testRealmKey(t, publicKey, "EdDSA", "SIG"),
+		{Kid: &secretKid, Type: &secretType},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(jwks.Keys) != 1 || jwks.Keys[0].Kid != "test-kid" {
+		t.Fatalf("expected only the key with a public key in the key set, got %+v", jwks.Keys)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 )
 
 func (keycloakClient *KeycloakClient) UpdateRealmLocalizationTexts(ctx context.Context, realmId string, locale string, texts map[string]string) error {
@@ -66,3 +67,11 @@ func (keycloakClient *KeycloakClient) DeleteRealmLocalizationTexts(ctx context.C
 	}
 	return nil
 }
+
+func (keycloakClient *KeycloakClient) UpdateRealmLocalizationText(ctx context.Context, realmId, locale, key, value string) error {
+	return keycloakClient.putPlain(ctx, fmt.Sprintf("/realms/%s/localization/%s/%s", realmId, locale, url.PathEscape(key)), value)
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmLocalizationText(ctx context.Context, realmId, locale, key string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/localization/%s/%s", realmId, locale, url.PathEscape(key)), nil)
+}
@@ -0,0 +1,117 @@
+package keycloak
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PasswordPolicyType describes a password policy provider as listed in the server info.
+type PasswordPolicyType struct {
+	Id                string `json:"id"`
+	DisplayName       string `json:"displayName"`
+	ConfigType        string `json:"configType"`
+	DefaultValue      string `json:"defaultValue"`
+	MultipleSupported bool   `json:"multipleSupported"`
+}
+
+// PasswordPolicyRule is a single policy of a realm password policy, e.g. "length(12)".
+type PasswordPolicyRule struct {
+	Type  string
+	Value string
+}
+
+func (rule PasswordPolicyRule) String() string {
+	if rule.Value == "" {
+		return rule.Type
+	}
+
+	return fmt.Sprintf("%s(%s)", rule.Type, rule.Value)
+}
+
+// ParsePasswordPolicy splits a password policy string into its rules the same way Keycloak does: policies are
+// separated by " and " and their value is everything between the first "(" and the last ")".
+func ParsePasswordPolicy(passwordPolicy string) []PasswordPolicyRule {
+	var rules []PasswordPolicyRule
+	for _, policy := range strings.Split(passwordPolicy, " and ") {
+		policy = strings.TrimSpace(policy)
+		if policy == "" {
+			continue
+		}
+
+		rule := PasswordPolicyRule{Type: policy}
+		if start := strings.Index(policy, "("); start != -1 {
+			end := strings.LastIndex(policy, ")")
+			if end < start {
+				end = len(policy)
+			}
+			rule.Type = strings.TrimSpace(policy[:start])
+			rule.Value = strings.TrimSpace(policy[start+1 : end])
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// FormatPasswordPolicy renders rules to a password policy string. Rules are sorted so that the same set of rules always
+// results in the same string.
+func FormatPasswordPolicy(rules []PasswordPolicyRule) string {
+	sorted := make([]PasswordPolicyRule, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Type != sorted[j].Type {
+			return sorted[i].Type < sorted[j].Type
+		}
+		return sorted[i].Value < sorted[j].Value
+	})
+
+	policies := make([]string, 0, len(sorted))
+	for _, rule := range sorted {
+		policies = append(policies, rule.String())
+	}
+
+	return strings.Join(policies, " and ")
+}
+
+// NormalizePasswordPolicy returns the canonical form of a password policy string, so that policies which only differ
+// in the order of their rules compare equal.
+func NormalizePasswordPolicy(passwordPolicy string) string {
+	return FormatPasswordPolicy(ParsePasswordPolicy(passwordPolicy))
+}
+
+// ValidatePasswordPolicy checks the rules against the password policy providers installed on the server. When the
+// server info describes the providers, the values are checked against their config type as well.
+func (serverInfo *ServerInfo) ValidatePasswordPolicy(rules []PasswordPolicyRule) error {
+	policyTypes := make(map[string]PasswordPolicyType, len(serverInfo.PasswordPolicies))
+	for _, policyType := range serverInfo.PasswordPolicies {
+		policyTypes[policyType.Id] = policyType
+	}
+
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		if !serverInfo.providerInstalled("password-policy", rule.Type) {
+			return fmt.Errorf("validation error: password-policy \"%s\" does not exist on the server, installed providers: %s", rule.Type, serverInfo.getInstalledProvidersNames("password-policy"))
+		}
+
+		policyType, ok := policyTypes[rule.Type]
+		if !ok {
+			continue
+		}
+
+		if seen[rule.Type] && !policyType.MultipleSupported {
+			return fmt.Errorf("validation error: password-policy \"%s\" can only be used once", rule.Type)
+		}
+		seen[rule.Type] = true
+
+		if policyType.ConfigType == "int" && rule.Value != "" {
+			if _, err := strconv.Atoi(rule.Value); err != nil {
+				return fmt.Errorf("validation error: password-policy \"%s\" expects an integer value, got \"%s\"", rule.Type, rule.Value)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package keycloak
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePasswordPolicy(t *testing.T) {
+	rules := ParsePasswordPolicy("length(12) and notUsername and regexPattern(^(a|b)+$) and passwordHistory( 5 )")
+
+	expected := []PasswordPolicyRule{
+		{Type: "length", Value: "12"},
+		{Type: "notUsername"},
+		{Type: "regexPattern", Value: "^(a|b)+$"},
+		{Type: "passwordHistory", Value: "5"},
+	}
+	if !reflect.DeepEqual(rules, expected) {
+		t.Fatalf("expected %v, got %v", expected, rules)
+	}
+
+	if rules := ParsePasswordPolicy(""); len(rules) != 0 {
+		t.Fatalf("expected no rules for an empty policy, got %v", rules)
+	}
+}
+
+func TestNormalizePasswordPolicy(t *testing.T) {
+	a := NormalizePasswordPolicy("upperCase(1) and length(12) and passwordHistory(5)")
+	b := NormalizePasswordPolicy("passwordHistory(5) and upperCase(1) and length(12)")
+
+	if a != b {
+		t.Fatalf("expected reordered policies to normalize to the same string, got %q and %q", a, b)
+	}
+
+	if a != "length(12) and passwordHistory(5) and upperCase(1)" {
+		t.Fatalf("unexpected normalized policy %q", a)
+	}
+}
+
+func TestServerInfoValidatePasswordPolicy(t *testing.T) {
+	serverInfo := &ServerInfo{
+		ProviderTypes: map[string]ProviderType{
+			"password-policy": {
+				Providers: map[string]Provider{
+					"length":       {},
+					"notUsername":  {},
+					"regexPattern": {},
+				},
+			},
+		},
+		PasswordPolicies: []PasswordPolicyType{
+			{Id: "length", ConfigType: "int"},
+			{Id: "notUsername"},
+			{Id: "regexPattern", ConfigType: "String", MultipleSupported: true},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		policy string
+		err    string
+	}{
+		{name: "valid", policy: "length(12) and notUsername and regexPattern(a) and regexPattern(b)"},
+		{name: "unknown provider", policy: "lenght(12)", err: "password-policy \"lenght\" does not exist on the server"},
+		{name: "invalid integer", policy: "length(twelve)", err: "expects an integer value"},
+		{name: "duplicate", policy: "length(12) and length(14)", err: "can only be used once"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := serverInfo.ValidatePasswordPolicy(ParsePasswordPolicy(test.policy))
+			if test.err == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("expected error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
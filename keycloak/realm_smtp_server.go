@@ -0,0 +1,39 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+func (keycloakClient *KeycloakClient) GetRealmSmtpServer(ctx context.Context, realmId string) (*SmtpServer, error) {
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &realm.SmtpServer, nil
+}
+
+// UpdateRealmSmtpServer replaces the smtp settings of a realm while leaving every other realm setting untouched.
+// An empty SmtpServer removes the smtp configuration from the realm.
+func (keycloakClient *KeycloakClient) UpdateRealmSmtpServer(ctx context.Context, realmId string, smtpServer *SmtpServer) error {
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	realm.SmtpServer = *smtpServer
+
+	return keycloakClient.UpdateRealm(ctx, realm)
+}
+
+// TestRealmSmtpServer asks Keycloak to send a test email with the given smtp settings. The email is sent to the address
+// of the user the provider is authenticated as, so that user needs to have an email address.
+func (keycloakClient *KeycloakClient) TestRealmSmtpServer(ctx context.Context, realmId string, smtpServer *SmtpServer) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/testSMTPConnection", realmId), smtpServer)
+	if err != nil {
+		return fmt.Errorf("smtp connection test for realm %s failed: %v", realmId, err)
+	}
+
+	return nil
+}
@@ -115,3 +115,172 @@ func (keycloakClient *KeycloakClient) GetRealmUserProfile(ctx context.Context, r
 	}
 	return &realmUserProfile, nil
 }
+
+// The fields of an attribute or group that are managed through RealmUserProfileAttribute and RealmUserProfileGroup.
+// Any other field of an existing entry is kept when the entry is updated.
+var (
+	realmUserProfileAttributeFields = []string{"annotations", "defaultValue", "displayName", "group", "multivalued", "permissions", "required", "selector", "validations"}
+	realmUserProfileGroupFields     = []string{"annotations", "displayDescription", "displayHeader"}
+)
+
+// getRealmUserProfileDocument returns the user profile as a generic JSON document, so that it can be modified without
+// losing fields that RealmUserProfile doesn't know about
+func (keycloakClient *KeycloakClient) getRealmUserProfileDocument(ctx context.Context, realmId string) (map[string]interface{}, error) {
+	body, err := keycloakClient.getRaw(ctx, fmt.Sprintf("/realms/%s/users/profile", realmId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if string(body) == "" {
+		return nil, fmt.Errorf("User Profile is disabled for the %s realm", realmId)
+	}
+
+	var document map[string]interface{}
+	err = json.Unmarshal(body, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+func upsertRealmUserProfileEntry(document map[string]interface{}, list, name string, entry interface{}, fields []string) error {
+	var updatedEntry map[string]interface{}
+
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(body, &updatedEntry)
+	if err != nil {
+		return err
+	}
+
+	entries, _ := document[list].([]interface{})
+	for _, e := range entries {
+		existingEntry, ok := e.(map[string]interface{})
+		if !ok || existingEntry["name"] != name {
+			continue
+		}
+
+		for _, field := range fields {
+			delete(existingEntry, field)
+		}
+		for key, value := range updatedEntry {
+			existingEntry[key] = value
+		}
+
+		return nil
+	}
+
+	document[list] = append(entries, updatedEntry)
+
+	return nil
+}
+
+func removeRealmUserProfileEntry(document map[string]interface{}, list, name string) bool {
+	entries, _ := document[list].([]interface{})
+	for i, e := range entries {
+		if existingEntry, ok := e.(map[string]interface{}); ok && existingEntry["name"] == name {
+			document[list] = append(entries[:i], entries[i+1:]...)
+			return true
+		}
+	}
+
+	return false
+}
+
+func (keycloakClient *KeycloakClient) GetRealmUserProfileAttribute(ctx context.Context, realmId, name string) (*RealmUserProfileAttribute, error) {
+	realmUserProfile, err := keycloakClient.GetRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attribute := range realmUserProfile.Attributes {
+		if attribute.Name == name {
+			return attribute, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    404,
+		Message: fmt.Sprintf("user profile attribute %s not found in realm %s", name, realmId),
+	}
+}
+
+// UpdateRealmUserProfileAttribute creates or replaces a single attribute of the user profile, all other attributes
+// and groups of the user profile are kept as they are
+func (keycloakClient *KeycloakClient) UpdateRealmUserProfileAttribute(ctx context.Context, realmId string, attribute *RealmUserProfileAttribute) error {
+	document, err := keycloakClient.getRealmUserProfileDocument(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	err = upsertRealmUserProfileEntry(document, "attributes", attribute.Name, attribute, realmUserProfileAttributeFields)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/profile", realmId), document)
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmUserProfileAttribute(ctx context.Context, realmId, name string) error {
+	document, err := keycloakClient.getRealmUserProfileDocument(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	if !removeRealmUserProfileEntry(document, "attributes", name) {
+		return nil
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/profile", realmId), document)
+}
+
+func (keycloakClient *KeycloakClient) GetRealmUserProfileGroup(ctx context.Context, realmId, name string) (*RealmUserProfileGroup, error) {
+	realmUserProfile, err := keycloakClient.GetRealmUserProfile(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range realmUserProfile.Groups {
+		if group.Name == name {
+			return group, nil
+		}
+	}
+
+	return nil, &ApiError{
+		Code:    404,
+		Message: fmt.Sprintf("user profile group %s not found in realm %s", name, realmId),
+	}
+}
+
+// UpdateRealmUserProfileGroup creates or replaces a single group of the user profile, all other attributes and groups
+// of the user profile are kept as they are
+func (keycloakClient *KeycloakClient) UpdateRealmUserProfileGroup(ctx context.Context, realmId string, group *RealmUserProfileGroup) error {
+	document, err := keycloakClient.getRealmUserProfileDocument(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	err = upsertRealmUserProfileEntry(document, "groups", group.Name, group, realmUserProfileGroupFields)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/profile", realmId), document)
+}
+
+func (keycloakClient *KeycloakClient) DeleteRealmUserProfileGroup(ctx context.Context, realmId, name string) error {
+	document, err := keycloakClient.getRealmUserProfileDocument(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	if !removeRealmUserProfileEntry(document, "groups", name) {
+		return nil
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/profile", realmId), document)
+}
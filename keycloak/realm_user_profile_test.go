@@ -0,0 +1,74 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUpsertRealmUserProfileEntryKeepsUnknownFields(t *testing.T) {
+	var document map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"attributes": [
+			{"name": "username", "displayName": "Username"},
+			{"name": "department", "displayName": "Old", "futureField": "kept", "validations": {"length": {"max": 10}}}
+		],
+		"unmanagedAttributePolicy": "ENABLED",
+		"futureTopLevelField": true
+	}`), &document)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = upsertRealmUserProfileEntry(document, "attributes", "department", &RealmUserProfileAttribute{Name: "department", DisplayName: "Department"}, realmUserProfileAttributeFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = upsertRealmUserProfileEntry(document, "attributes", "employee_id", &RealmUserProfileAttribute{Name: "employee_id"}, realmUserProfileAttributeFields)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attributes := document["attributes"].([]interface{})
+	if len(attributes) != 3 {
+		t.Fatalf("expected 3 attributes, got %d", len(attributes))
+	}
+
+	department := attributes[1].(map[string]interface{})
+	if department["displayName"] != "Department" || department["futureField"] != "kept" {
+		t.Fatalf("unexpected department attribute %v", department)
+	}
+	if _, ok := department["validations"]; ok {
+		t.Fatalf("expected validations that are not configured anymore to be removed, got %v", department["validations"])
+	}
+
+	if attributes[2].(map[string]interface{})["name"] != "employee_id" {
+		t.Fatalf("expected new attribute to be appended, got %v", attributes[2])
+	}
+
+	if document["unmanagedAttributePolicy"] != "ENABLED" || document["futureTopLevelField"] != true {
+		t.Fatalf("expected other fields of the user profile to be kept, got %v", document)
+	}
+}
+
+func TestRemoveRealmUserProfileEntry(t *testing.T) {
+	document := map[string]interface{}{
+		"groups": []interface{}{
+			map[string]interface{}{"name": "user-metadata"},
+			map[string]interface{}{"name": "employment"},
+		},
+	}
+
+	if removeRealmUserProfileEntry(document, "groups", "unknown") {
+		t.Fatal("expected unknown group not to be removed")
+	}
+
+	if !removeRealmUserProfileEntry(document, "groups", "user-metadata") {
+		t.Fatal("expected group to be removed")
+	}
+
+	groups := document["groups"].([]interface{})
+	if len(groups) != 1 || groups[0].(map[string]interface{})["name"] != "employment" {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+}
@@ -0,0 +1,202 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// UnmanagedAttributesDiscarded returns true when attributes that are not part of the user profile can't be
+// changed by an administrator, so Keycloak silently drops them when a user is saved
+func (realmUserProfile *RealmUserProfile) UnmanagedAttributesDiscarded() bool {
+	policy := realmUserProfile.UnmanagedAttributePolicy
+
+	return policy == nil || *policy == "" || *policy == "DISABLED" || *policy == "ADMIN_VIEW"
+}
+
+// UnmanagedAttributes returns the attributes of the user that are not part of the user profile, sorted by name
+func (realmUserProfile *RealmUserProfile) UnmanagedAttributes(user *User) []string {
+	var unmanagedAttributes []string
+
+	for name := range user.Attributes {
+		if realmUserProfile.attribute(name) == nil {
+			unmanagedAttributes = append(unmanagedAttributes, name)
+		}
+	}
+
+	slices.Sort(unmanagedAttributes)
+
+	return unmanagedAttributes
+}
+
+// ValidateUser checks the user against the attributes of the user profile the way Keycloak does when an administrator
+// saves the user: required attributes, multivalued attributes and the length, pattern, email and options validators.
+// Attributes that are only enabled or required for some client scopes don't apply to administrators and are skipped.
+func (realmUserProfile *RealmUserProfile) ValidateUser(user *User) error {
+	var errs []error
+
+	for _, attribute := range realmUserProfile.Attributes {
+		if attribute.Name == "username" || (attribute.Selector != nil && len(attribute.Selector.Scopes) != 0) {
+			continue
+		}
+
+		values := userProfileAttributeValues(user, attribute.Name)
+
+		if attribute.requiredForAdmin() && !slices.ContainsFunc(values, func(value string) bool { return value != "" }) {
+			errs = append(errs, fmt.Errorf("validation error: attribute \"%s\" is required by the user profile", attribute.Name))
+			continue
+		}
+
+		if !attribute.MultiValued && len(values) > 1 {
+			errs = append(errs, fmt.Errorf("validation error: attribute \"%s\" is not multivalued in the user profile, but has %d values", attribute.Name, len(values)))
+		}
+
+		for _, validator := range slices.Sorted(maps.Keys(attribute.Validations)) {
+			for _, value := range values {
+				err := validateUserProfileValue(attribute.Name, validator, attribute.Validations[validator], value)
+				if err != nil {
+					errs = append(errs, err)
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (realmUserProfile *RealmUserProfile) attribute(name string) *RealmUserProfileAttribute {
+	for _, attribute := range realmUserProfile.Attributes {
+		if attribute.Name == name {
+			return attribute
+		}
+	}
+
+	return nil
+}
+
+func (attribute *RealmUserProfileAttribute) requiredForAdmin() bool {
+	if attribute.Required == nil || len(attribute.Required.Scopes) != 0 {
+		return false
+	}
+
+	return len(attribute.Required.Roles) == 0 || slices.Contains(attribute.Required.Roles, "admin")
+}
+
+// userProfileAttributeValues returns the values of an attribute, the built-in attributes are fields of the user
+func userProfileAttributeValues(user *User, name string) []string {
+	switch name {
+	case "email":
+		return []string{user.Email}
+	case "firstName":
+		return []string{user.FirstName}
+	case "lastName":
+		return []string{user.LastName}
+	default:
+		return user.Attributes[name]
+	}
+}
+
+func validateUserProfileValue(name, validator string, config RealmUserProfileValidationConfig, value string) error {
+	// like Keycloak, empty values are only checked by the required constraint unless told otherwise
+	if value == "" && validationConfigBool(config, "ignore.empty.value", true) {
+		return nil
+	}
+
+	switch validator {
+	case "length":
+		if !validationConfigBool(config, "trim-disabled", false) {
+			value = strings.TrimSpace(value)
+		}
+		length := utf8.RuneCountInString(value)
+
+		if minLength, ok := validationConfigInt(config, "min"); ok && length < minLength {
+			return fmt.Errorf("validation error: value \"%s\" of attribute \"%s\" must be at least %d characters long", value, name, minLength)
+		}
+		if maxLength, ok := validationConfigInt(config, "max"); ok && length > maxLength {
+			return fmt.Errorf("validation error: value \"%s\" of attribute \"%s\" must be at most %d characters long", value, name, maxLength)
+		}
+	case "pattern":
+		pattern, _ := config["pattern"].(string)
+		if pattern == "" {
+			return nil
+		}
+
+		// Java's Matcher.matches() requires the whole value to match, Java patterns that aren't supported by Go are left
+		// to the server
+		regex, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil
+		}
+
+		if !regex.MatchString(value) {
+			return fmt.Errorf("validation error: value \"%s\" of attribute \"%s\" doesn't match the pattern %s", value, name, pattern)
+		}
+	case "email":
+		address, err := mail.ParseAddress(value)
+		if err != nil || address.Address != value {
+			return fmt.Errorf("validation error: value \"%s\" of attribute \"%s\" is not a valid email address", value, name)
+		}
+	case "options":
+		options := validationConfigStrings(config, "options")
+		if !slices.Contains(options, value) {
+			return fmt.Errorf("validation error: value \"%s\" of attribute \"%s\" must be one of %s", value, name, strings.Join(options, ", "))
+		}
+	}
+
+	return nil
+}
+
+func validationConfigBool(config RealmUserProfileValidationConfig, key string, defaultValue bool) bool {
+	switch value := config[key].(type) {
+	case bool:
+		return value
+	case string:
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+	}
+
+	return defaultValue
+}
+
+func validationConfigInt(config RealmUserProfileValidationConfig, key string) (int, bool) {
+	switch value := config[key].(type) {
+	case float64:
+		return int(value), true
+	case int:
+		return value, true
+	case string:
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed, true
+		}
+	}
+
+	return 0, false
+}
+
+// validationConfigStrings returns a list of the config, GetRealmUserProfile serializes lists as JSON strings
+func validationConfigStrings(config RealmUserProfileValidationConfig, key string) []string {
+	var values []string
+
+	switch value := config[key].(type) {
+	case []interface{}:
+		for _, v := range value {
+			values = append(values, fmt.Sprint(v))
+		}
+	case []string:
+		values = value
+	case string:
+		if json.Unmarshal([]byte(value), &values) != nil {
+			values = []string{value}
+		}
+	}
+
+	return values
+}
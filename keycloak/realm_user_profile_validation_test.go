@@ -0,0 +1,102 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testRealmUserProfile(t *testing.T, document string) *RealmUserProfile {
+	var realmUserProfile RealmUserProfile
+	err := json.Unmarshal([]byte(document), &realmUserProfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &realmUserProfile
+}
+
+func TestRealmUserProfileValidateUser(t *testing.T) {
+	realmUserProfile := testRealmUserProfile(t, `{
+		"attributes": [
+			{"name": "username", "required": {}},
+			{"name": "email", "validations": {"email": {}, "length": {"max": 255}}, "required": {"roles": ["user"]}},
+			{"name": "firstName", "required": {"roles": ["admin", "user"]}},
+			{"name": "department", "required": {}, "validations": {"options": {"options": ["sales", "engineering"]}}},
+			{"name": "employee_id", "validations": {"pattern": {"pattern": "[0-9]+"}, "length": {"min": "3", "max": 6}}},
+			{"name": "level", "validations": {"pattern": {"pattern": "a|ab"}}},
+			{"name": "tags", "multivalued": true, "validations": {"length": {"max": 4}}},
+			{"name": "badge", "required": {"scopes": ["badge"]}},
+			{"name": "nickname", "selector": {"scopes": ["profile"]}, "required": {}}
+		]
+	}`)
+
+	valid := &User{
+		FirstName: "Bob",
+		Attributes: map[string][]string{
+			"department":  {"sales"},
+			"employee_id": {"123"},
+			"level":       {"ab"},
+			"tags":        {"a", " bcd "},
+		},
+	}
+	if err := realmUserProfile.ValidateUser(valid); err != nil {
+		t.Fatalf("expected user to be valid, got %v", err)
+	}
+
+	invalid := &User{
+		Email: "bob",
+		Attributes: map[string][]string{
+			"department":  {"marketing", "sales"},
+			"employee_id": {"12a"},
+			"level":       {"abc"},
+			"tags":        {"toolong"},
+		},
+	}
+	err := realmUserProfile.ValidateUser(invalid)
+	if err == nil {
+		t.Fatal("expected user to be invalid")
+	}
+
+	for _, expected := range []string{
+		`value "bob" of attribute "email" is not a valid email address`,
+		`attribute "firstName" is required by the user profile`,
+		`attribute "department" is not multivalued in the user profile, but has 2 values`,
+		`value "marketing" of attribute "department" must be one of sales, engineering`,
+		`value "12a" of attribute "employee_id" doesn't match the pattern [0-9]+`,
+		`value "abc" of attribute "level" doesn't match the pattern a|ab`,
+		`value "toolong" of attribute "tags" must be at most 4 characters long`,
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("expected error to contain %q, got %v", expected, err)
+		}
+	}
+
+	for _, unexpected := range []string{"username", "badge", "nickname"} {
+		if strings.Contains(err.Error(), `"`+unexpected+`"`) {
+			t.Errorf("expected attribute %s not to be validated, got %v", unexpected, err)
+		}
+	}
+}
+
+func TestRealmUserProfileUnmanagedAttributes(t *testing.T) {
+	realmUserProfile := testRealmUserProfile(t, `{"attributes": [{"name": "username"}, {"name": "department"}]}`)
+
+	user := &User{Attributes: map[string][]string{"department": {"sales"}, "b": {"1"}, "a": {"2"}}}
+	if unmanagedAttributes := realmUserProfile.UnmanagedAttributes(user); !reflect.DeepEqual(unmanagedAttributes, []string{"a", "b"}) {
+		t.Fatalf("expected unmanaged attributes a and b, got %v", unmanagedAttributes)
+	}
+
+	for policy, discarded := range map[string]bool{"": true, "DISABLED": true, "ADMIN_VIEW": true, "ADMIN_EDIT": false, "ENABLED": false} {
+		realmUserProfile.UnmanagedAttributePolicy = &policy
+		if realmUserProfile.UnmanagedAttributesDiscarded() != discarded {
+			t.Errorf("expected unmanaged attributes discarded to be %t for policy %q", discarded, policy)
+		}
+	}
+
+	realmUserProfile.UnmanagedAttributePolicy = nil
+	if !realmUserProfile.UnmanagedAttributesDiscarded() {
+		t.Error("expected unmanaged attributes to be discarded when no policy is set")
+	}
+}
@@ -3,6 +3,10 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 type RequiredAction struct {
@@ -117,3 +121,130 @@ func (keycloakClient *KeycloakClient) ValidateRequiredAction(ctx context.Context
 
 	return nil
 }
+
+type RequiredActionConfig struct {
+	Config map[string]string `json:"config"`
+}
+
+type RequiredActionConfigProperty struct {
+	Name         string      `json:"name"`
+	Label        string      `json:"label"`
+	HelpText     string      `json:"helpText"`
+	Type         string      `json:"type"`
+	DefaultValue interface{} `json:"defaultValue"`
+	Options      []string    `json:"options"`
+}
+
+type RequiredActionConfigDescription struct {
+	Properties []RequiredActionConfigProperty `json:"properties"`
+}
+
+// GetRequiredActionConfig returns the configuration stored through the dedicated config endpoint, available since Keycloak 25.
+// A required action without any stored configuration returns an empty map, and so does one that isn't configurable, which
+// Keycloak rejects with a 400.
+func (keycloakClient *KeycloakClient) GetRequiredActionConfig(ctx context.Context, realmId string, alias string) (map[string]string, error) {
+	var requiredActionConfig RequiredActionConfig
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/required-actions/%s/config", realmId, alias), &requiredActionConfig, nil)
+	if err != nil {
+		if ErrorIs404(err) || ErrorIs400(err) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+
+	if requiredActionConfig.Config == nil {
+		return map[string]string{}, nil
+	}
+
+	return requiredActionConfig.Config, nil
+}
+
+func (keycloakClient *KeycloakClient) UpdateRequiredActionConfig(ctx context.Context, realmId string, alias string, config map[string]string) error {
+	err := keycloakClient.ValidateRequiredActionConfig(ctx, realmId, alias, config)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/authentication/required-actions/%s/config", realmId, alias), &RequiredActionConfig{Config: config})
+}
+
+func (keycloakClient *KeycloakClient) DeleteRequiredActionConfig(ctx context.Context, realmId string, alias string) error {
+	err := keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/authentication/required-actions/%s/config", realmId, alias), nil)
+	if err != nil && !ErrorIs404(err) && !ErrorIs400(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetRequiredActionConfigDescription(ctx context.Context, realmId string, alias string) (*RequiredActionConfigDescription, error) {
+	var configDescription RequiredActionConfigDescription
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/authentication/required-actions/%s/config-description", realmId, alias), &configDescription, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &configDescription, nil
+}
+
+// ValidateRequiredActionConfig checks the given configuration against the config metadata the required action provider
+// exposes. Depending on the version, Keycloak rejects the config description of a required action that isn't configurable
+// with a 400 or a 404.
+func (keycloakClient *KeycloakClient) ValidateRequiredActionConfig(ctx context.Context, realmId string, alias string, config map[string]string) error {
+	if len(config) == 0 {
+		return nil
+	}
+
+	configDescription, err := keycloakClient.GetRequiredActionConfigDescription(ctx, realmId, alias)
+	if err != nil {
+		if ErrorIs404(err) || ErrorIs400(err) {
+			return fmt.Errorf("validation error: required action \"%s\" does not support configuration", alias)
+		}
+		return err
+	}
+
+	return configDescription.validate(alias, config)
+}
+
+func (configDescription *RequiredActionConfigDescription) validate(alias string, config map[string]string) error {
+	properties := make(map[string]RequiredActionConfigProperty, len(configDescription.Properties))
+	var names []string
+	for _, property := range configDescription.Properties {
+		properties[property.Name] = property
+		names = append(names, property.Name)
+	}
+	sort.Strings(names)
+
+	var keys []string
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		property, ok := properties[key]
+		if !ok {
+			return fmt.Errorf("validation error: config key \"%s\" is not supported by required action \"%s\", supported keys: %s", key, alias, strings.Join(names, ", "))
+		}
+
+		value := config[key]
+		switch strings.ToLower(property.Type) {
+		case "boolean":
+			if _, err := strconv.ParseBool(value); err != nil {
+				return fmt.Errorf("validation error: config key \"%s\" of required action \"%s\" must be a boolean, got \"%s\"", key, alias, value)
+			}
+		case "int", "integer":
+			if _, err := strconv.Atoi(value); err != nil {
+				return fmt.Errorf("validation error: config key \"%s\" of required action \"%s\" must be an integer, got \"%s\"", key, alias, value)
+			}
+		case "list":
+			if len(property.Options) != 0 && !slices.Contains(property.Options, value) {
+				return fmt.Errorf("validation error: config key \"%s\" of required action \"%s\" must be one of %s, got \"%s\"", key, alias, strings.Join(property.Options, ", "), value)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package keycloak
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRequiredActionConfigDescriptionValidate(t *testing.T) {
+	configDescription := &RequiredActionConfigDescription{
+		Properties: []RequiredActionConfigProperty{
+			{Name: "max_auth_age", Type: "int"},
+			{Name: "force_verification", Type: "boolean"},
+			{Name: "mode", Type: "List", Options: []string{"email", "sms"}},
+			{Name: "label", Type: "String"},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		config map[string]string
+		err    string
+	}{
+		{name: "valid", config: map[string]string{"max_auth_age": "600", "force_verification": "true", "mode": "sms", "label": "x"}},
+		{name: "unknown key", config: map[string]string{"max_auth_ages": "600"}, err: "supported keys: force_verification, label, max_auth_age, mode"},
+		{name: "invalid integer", config: map[string]string{"max_auth_age": "ten"}, err: "must be an integer"},
+		{name: "invalid boolean", config: map[string]string{"force_verification": "yes"}, err: "must be a boolean"},
+		{name: "invalid option", config: map[string]string{"mode": "voice"}, err: "must be one of email, sms"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := configDescription.validate("ACTION", test.config)
+			if test.err == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("expected error containing %q, got %v", test.err, err)
+			}
+		})
+	}
+}
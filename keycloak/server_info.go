@@ -12,8 +12,9 @@ type ComponentType struct {
 }
 
 type ComponentTypeProperty struct {
-	Name string `json:"name"`
-	Type string `json:"type"`
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Options []string `json:"options"`
 }
 
 type ProviderType struct {
@@ -35,11 +36,12 @@ type FeatureRepresentation struct {
 }
 
 type ServerInfo struct {
-	SystemInfo     SystemInfo                 `json:"systemInfo"`
-	ComponentTypes map[string][]ComponentType `json:"componentTypes"`
-	ProviderTypes  map[string]ProviderType    `json:"providers"`
-	Themes         map[string][]Theme         `json:"themes"`
-	Features       []FeatureRepresentation    `json:"features"`
+	SystemInfo       SystemInfo                 `json:"systemInfo"`
+	ComponentTypes   map[string][]ComponentType `json:"componentTypes"`
+	ProviderTypes    map[string]ProviderType    `json:"providers"`
+	Themes           map[string][]Theme         `json:"themes"`
+	Features         []FeatureRepresentation    `json:"features"`
+	PasswordPolicies []PasswordPolicyType       `json:"passwordPolicies"`
 }
 
 func (serverInfo *ServerInfo) ThemeIsInstalled(t, themeName string) bool {
@@ -3,8 +3,16 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"maps"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
 )
 
+const usersPageSize = 100
+
 type FederatedIdentity struct {
 	IdentityProvider string `json:"identityProvider"`
 	UserId           string `json:"userId"`
@@ -26,6 +34,7 @@ type User struct {
 	Attributes          map[string][]string `json:"attributes"`
 	FederatedIdentities FederatedIdentities `json:"federatedIdentities"`
 	RequiredActions     []string            `json:"requiredActions"`
+	Credentials         []*UserCredential   `json:"credentials,omitempty"`
 }
 
 type PasswordCredentials struct {
@@ -46,6 +55,7 @@ func (keycloakClient *KeycloakClient) NewUser(ctx context.Context, user *User) e
 		Enabled:         user.Enabled,
 		Attributes:      user.Attributes,
 		RequiredActions: user.RequiredActions,
+		Credentials:     user.Credentials,
 	}
 	_, location, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users", user.RealmId), newUser)
 	if err != nil {
@@ -55,7 +65,7 @@ func (keycloakClient *KeycloakClient) NewUser(ctx context.Context, user *User) e
 	user.Id = getIdFromLocationHeader(location)
 
 	for _, federatedIdentity := range user.FederatedIdentities {
-		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), federatedIdentity)
+		err := keycloakClient.NewUserFederatedIdentity(ctx, user.RealmId, user.Id, federatedIdentity)
 		if err != nil {
 			return err
 		}
@@ -93,6 +103,115 @@ func (keycloakClient *KeycloakClient) GetUsers(ctx context.Context, realmId stri
 	return users, nil
 }
 
+// UsersQuery holds the filters of the users search endpoint, empty filters are not sent. Keycloak ignores every filter
+// but Enabled when Search is set, so they can't be combined.
+type UsersQuery struct {
+	Search    string
+	Username  string
+	Email     string
+	FirstName string
+	LastName  string
+	Exact     *bool
+	Enabled   *bool
+	IdpAlias  string
+	// Attributes are sent as a single "q" parameter, in the format key1:value1 key2:value2
+	Attributes map[string]string
+}
+
+func (query *UsersQuery) validate() error {
+	if query.Search != "" && (query.Username != "" || query.Email != "" || query.FirstName != "" || query.LastName != "" || query.IdpAlias != "" || query.Exact != nil || len(query.Attributes) != 0) {
+		return fmt.Errorf("validation error: search can only be combined with the enabled filter, Keycloak ignores the other filters when searching")
+	}
+
+	for key, value := range query.Attributes {
+		if !isUsersQueryAttributeToken(key) || !isUsersQueryAttributeToken(value) {
+			return fmt.Errorf("validation error: attribute %s with value %s can't be searched for, attribute names and values must not contain spaces or colons", key, value)
+		}
+	}
+
+	return nil
+}
+
+// isUsersQueryAttributeToken reports whether s can be used in the q parameter, which separates attributes by spaces
+// and names from values by colons
+func isUsersQueryAttributeToken(s string) bool {
+	return !strings.ContainsFunc(s, unicode.IsSpace) && !strings.Contains(s, ":")
+}
+
+func (query *UsersQuery) values() url.Values {
+	values := url.Values{
+		"briefRepresentation": []string{"false"},
+	}
+	addQueryParam(values, "search", query.Search)
+	addQueryParam(values, "username", query.Username)
+	addQueryParam(values, "email", query.Email)
+	addQueryParam(values, "firstName", query.FirstName)
+	addQueryParam(values, "lastName", query.LastName)
+	addQueryParam(values, "idpAlias", query.IdpAlias)
+
+	if query.Exact != nil {
+		values.Set("exact", strconv.FormatBool(*query.Exact))
+	}
+	if query.Enabled != nil {
+		values.Set("enabled", strconv.FormatBool(*query.Enabled))
+	}
+
+	var attributes []string
+	for _, key := range slices.Sorted(maps.Keys(query.Attributes)) {
+		attributes = append(attributes, fmt.Sprintf("%s:%s", key, query.Attributes[key]))
+	}
+	addQueryParam(values, "q", strings.Join(attributes, " "))
+
+	return values
+}
+
+// SearchUsers returns the full representation of every user of a realm that matches the query, fetching them page by page
+func (keycloakClient *KeycloakClient) SearchUsers(ctx context.Context, realmId string, query *UsersQuery) ([]*User, error) {
+	err := query.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return getPaginatedUsers(ctx, keycloakClient, fmt.Sprintf("/realms/%s/users", realmId), realmId, query.values())
+}
+
+// GetAllUsers returns the full representation of every user of a realm, fetching them page by page
+func (keycloakClient *KeycloakClient) GetAllUsers(ctx context.Context, realmId string) ([]*User, error) {
+	return keycloakClient.SearchUsers(ctx, realmId, &UsersQuery{})
+}
+
+// getPaginatedUsers fetches all the users returned by an endpoint that supports the first and max query parameters
+func getPaginatedUsers(ctx context.Context, keycloakClient *KeycloakClient, path, realmId string, values url.Values) ([]*User, error) {
+	var users []*User
+
+	if values == nil {
+		values = url.Values{}
+	}
+	values.Set("max", strconv.Itoa(usersPageSize))
+
+	for {
+		values.Set("first", strconv.Itoa(len(users)))
+
+		var page []*User
+		err := keycloakClient.getWithQuery(ctx, path, &page, values)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, page...)
+
+		if len(page) < usersPageSize {
+			break
+		}
+	}
+
+	for _, user := range users {
+		user.RealmId = realmId
+	}
+
+	return users, nil
+}
+
 func (keycloakClient *KeycloakClient) GetUser(ctx context.Context, realmId, id string) (*User, error) {
 	var user User
 
@@ -106,30 +225,19 @@ func (keycloakClient *KeycloakClient) GetUser(ctx context.Context, realmId, id s
 	return &user, nil
 }
 
+// UpdateUser updates a user. The federated identities of the user are only updated when they aren't nil, so links
+// managed elsewhere or created when logging in through an identity provider are kept.
 func (keycloakClient *KeycloakClient) UpdateUser(ctx context.Context, user *User) error {
 	err := keycloakClient.put(ctx, fmt.Sprintf("/realms/%s/users/%s", user.RealmId, user.Id), user)
 	if err != nil {
 		return err
 	}
 
-	var federatedIdentities []*FederatedIdentity
-	err = keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity", user.RealmId, user.Id), &federatedIdentities, nil)
-	if err != nil {
-		return err
-	}
-
-	for _, federatedIdentity := range federatedIdentities {
-		keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), nil)
+	if user.FederatedIdentities == nil {
+		return nil
 	}
 
-	for _, federatedIdentity := range user.FederatedIdentities {
-		_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", user.RealmId, user.Id, federatedIdentity.IdentityProvider), federatedIdentity)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return keycloakClient.updateUserFederatedIdentities(ctx, user.RealmId, user.Id, user.FederatedIdentities)
 }
 
 func (keycloakClient *KeycloakClient) DeleteUser(ctx context.Context, realmId, id string) error {
@@ -0,0 +1,48 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+)
+
+const offlineTokenGrantKey = "Offline Token"
+
+type UserConsentAdditionalGrant struct {
+	Client string `json:"client"`
+	Key    string `json:"key"`
+}
+
+// UserConsent is the consent of a user to a client. Clients the user holds an offline token for are listed as well,
+// even if the user never gave consent to them.
+type UserConsent struct {
+	ClientId            string                        `json:"clientId"`
+	GrantedClientScopes []string                      `json:"grantedClientScopes"`
+	CreatedDate         int64                         `json:"createdDate"`
+	LastUpdatedDate     int64                         `json:"lastUpdatedDate"`
+	AdditionalGrants    []*UserConsentAdditionalGrant `json:"additionalGrants"`
+}
+
+func (consent *UserConsent) HasOfflineToken() bool {
+	return slices.ContainsFunc(consent.AdditionalGrants, func(grant *UserConsentAdditionalGrant) bool {
+		return grant.Key == offlineTokenGrantKey
+	})
+}
+
+func (keycloakClient *KeycloakClient) GetUserConsents(ctx context.Context, realmId, userId string) ([]*UserConsent, error) {
+	var consents []*UserConsent
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/consents", realmId, userId), &consents, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return consents, nil
+}
+
+// RevokeUserConsent revokes the consent of a user to a client and the offline tokens the client holds for the user.
+// The clientId is the client_id of the client, not its id.
+func (keycloakClient *KeycloakClient) RevokeUserConsent(ctx context.Context, realmId, userId, clientId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/consents/%s", realmId, userId, url.PathEscape(clientId)), nil)
+}
@@ -0,0 +1,200 @@
+package keycloak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// UserCredential is the representation of a stored credential of a user. The credentialData and secretData fields
+// are JSON documents that are serialized as strings by Keycloak.
+type UserCredential struct {
+	Id             string `json:"id,omitempty"`
+	Type           string `json:"type"`
+	UserLabel      string `json:"userLabel,omitempty"`
+	CreatedDate    int64  `json:"createdDate,omitempty"`
+	SecretData     string `json:"secretData,omitempty"`
+	CredentialData string `json:"credentialData,omitempty"`
+	Priority       int    `json:"priority,omitempty"`
+	Temporary      bool   `json:"temporary,omitempty"`
+}
+
+type passwordCredentialData struct {
+	HashIterations       int                 `json:"hashIterations"`
+	Algorithm            string              `json:"algorithm"`
+	AdditionalParameters map[string][]string `json:"additionalParameters"`
+}
+
+type passwordSecretData struct {
+	Value                string              `json:"value"`
+	Salt                 string              `json:"salt"`
+	AdditionalParameters map[string][]string `json:"additionalParameters"`
+}
+
+type otpCredentialData struct {
+	SubType   string `json:"subType"`
+	Digits    int    `json:"digits"`
+	Counter   int    `json:"counter"`
+	Period    int    `json:"period"`
+	Algorithm string `json:"algorithm"`
+}
+
+type otpSecretData struct {
+	Value string `json:"value"`
+}
+
+// NewPasswordHashCredential builds a password credential from a hash that was computed outside of Keycloak, so users
+// can be migrated without knowing their plaintext passwords. The hash is passed on as is, in whatever format the
+// password hashing provider of the algorithm expects: the built-in providers expect it base64 encoded, while custom
+// providers may take other formats such as bcrypt's modular crypt strings. The salt, if any, is base64 encoded.
+func NewPasswordHashCredential(algorithm string, iterations int, hash, salt string, additionalParameters map[string][]string, temporary bool) (*UserCredential, error) {
+	if additionalParameters == nil {
+		additionalParameters = map[string][]string{}
+	}
+
+	credentialData, err := json.Marshal(&passwordCredentialData{
+		HashIterations:       iterations,
+		Algorithm:            algorithm,
+		AdditionalParameters: additionalParameters,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secretData, err := json.Marshal(&passwordSecretData{
+		Value:                hash,
+		Salt:                 salt,
+		AdditionalParameters: map[string][]string{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserCredential{
+		Type:           "password",
+		CredentialData: string(credentialData),
+		SecretData:     string(secretData),
+		Temporary:      temporary,
+	}, nil
+}
+
+// NewOtpCredential builds an OTP credential from an existing shared secret
+func NewOtpCredential(otpType, algorithm string, digits, period, counter int, secret, label string) (*UserCredential, error) {
+	credentialData, err := json.Marshal(&otpCredentialData{
+		SubType:   otpType,
+		Digits:    digits,
+		Counter:   counter,
+		Period:    period,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secretData, err := json.Marshal(&otpSecretData{
+		Value: secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserCredential{
+		Type:           "otp",
+		UserLabel:      label,
+		CredentialData: string(credentialData),
+		SecretData:     string(secretData),
+	}, nil
+}
+
+// passwordHashAlgorithm returns the hashing algorithm of a password credential, or an empty string for other credentials
+func (credential *UserCredential) passwordHashAlgorithm() (string, error) {
+	if credential.Type != "password" || credential.CredentialData == "" {
+		return "", nil
+	}
+
+	var credentialData passwordCredentialData
+	err := json.Unmarshal([]byte(credential.CredentialData), &credentialData)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse the credential data of password credential: %v", err)
+	}
+
+	return credentialData.Algorithm, nil
+}
+
+// ValidateUserCredentials checks that the password hashes of the given credentials were computed with an algorithm
+// that one of the password hashing providers installed on the server can verify
+func (keycloakClient *KeycloakClient) ValidateUserCredentials(ctx context.Context, credentials []*UserCredential) error {
+	if len(credentials) == 0 {
+		return nil
+	}
+
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	return serverInfo.validateUserCredentials(credentials)
+}
+
+func (serverInfo *ServerInfo) validateUserCredentials(credentials []*UserCredential) error {
+	for _, credential := range credentials {
+		algorithm, err := credential.passwordHashAlgorithm()
+		if err != nil {
+			return err
+		}
+
+		if algorithm != "" && !serverInfo.providerInstalled("password-hashing", algorithm) {
+			return fmt.Errorf("validation error: password hashing algorithm \"%s\" does not exist on the server, installed providers: %s", algorithm, serverInfo.getInstalledProvidersNames("password-hashing"))
+		}
+	}
+
+	return nil
+}
+
+func (keycloakClient *KeycloakClient) GetUserCredentials(ctx context.Context, realmId, userId string) ([]*UserCredential, error) {
+	var credentials []*UserCredential
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/credentials", realmId, userId), &credentials, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the secrets are never returned by Keycloak, but make sure they can't end up in the state either
+	for _, credential := range credentials {
+		credential.SecretData = ""
+	}
+
+	return credentials, nil
+}
+
+func (keycloakClient *KeycloakClient) DeleteUserCredential(ctx context.Context, realmId, userId, credentialId string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/credentials/%s", realmId, userId, credentialId), nil)
+}
+
+// UpdateUserCredentialLabel renames a credential. Keycloak expects the new label as a plain text body.
+func (keycloakClient *KeycloakClient) UpdateUserCredentialLabel(ctx context.Context, realmId, userId, credentialId, userLabel string) error {
+	resourceUrl := keycloakClient.baseUrl + apiUrl + fmt.Sprintf("/realms/%s/users/%s/credentials/%s/userLabel", realmId, userId, credentialId)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPut, resourceUrl, nil)
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-type", "text/plain")
+
+	_, _, err = keycloakClient.sendRequest(ctx, request, []byte(userLabel))
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) MoveUserCredentialToFirst(ctx context.Context, realmId, userId, credentialId string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/credentials/%s/moveToFirst", realmId, userId, credentialId), nil)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) MoveUserCredentialAfter(ctx context.Context, realmId, userId, credentialId, previousCredentialId string) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/credentials/%s/moveAfter/%s", realmId, userId, credentialId, previousCredentialId), nil)
+
+	return err
+}
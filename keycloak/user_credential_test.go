@@ -0,0 +1,74 @@
+package keycloak
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNewPasswordHashCredential(t *testing.T) {
+	credential, err := NewPasswordHashCredential("pbkdf2-sha256", 27500, "aGFzaA==", "c2FsdA==", nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if credential.Type != "password" || !credential.Temporary {
+		t.Fatalf("expected a temporary password credential, got %+v", credential)
+	}
+
+	var credentialData map[string]interface{}
+	if err := json.Unmarshal([]byte(credential.CredentialData), &credentialData); err != nil {
+		t.Fatal(err)
+	}
+	if credentialData["algorithm"] != "pbkdf2-sha256" || credentialData["hashIterations"] != float64(27500) {
+		t.Fatalf("unexpected credential data %s", credential.CredentialData)
+	}
+
+	var secretData map[string]interface{}
+	if err := json.Unmarshal([]byte(credential.SecretData), &secretData); err != nil {
+		t.Fatal(err)
+	}
+	if secretData["value"] != "aGFzaA==" || secretData["salt"] != "c2FsdA==" {
+		t.Fatalf("unexpected secret data %s", credential.SecretData)
+	}
+}
+
+func TestNewOtpCredential(t *testing.T) {
+	credential, err := NewOtpCredential("totp", "HmacSHA1", 6, 30, 0, "JBSWY3DPEHPK3PXP", "phone")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if credential.Type != "otp" || credential.UserLabel != "phone" {
+		t.Fatalf("expected an otp credential labelled phone, got %+v", credential)
+	}
+
+	if !strings.Contains(credential.CredentialData, `"subType":"totp"`) || credential.SecretData != `{"value":"JBSWY3DPEHPK3PXP"}` {
+		t.Fatalf("unexpected otp credential %+v", credential)
+	}
+}
+
+func TestValidateUserCredentials(t *testing.T) {
+	serverInfo := &ServerInfo{
+		ProviderTypes: map[string]ProviderType{
+			"password-hashing": {
+				Providers: map[string]Provider{
+					"pbkdf2-sha256": {},
+				},
+			},
+		},
+	}
+
+	installed, _ := NewPasswordHashCredential("pbkdf2-sha256", 27500, "aGFzaA==", "c2FsdA==", nil, false)
+	missing, _ := NewPasswordHashCredential("bcrypt", 0, "aGFzaA==", "", nil, false)
+	otp, _ := NewOtpCredential("totp", "HmacSHA1", 6, 30, 0, "JBSWY3DPEHPK3PXP", "")
+
+	if err := serverInfo.validateUserCredentials([]*UserCredential{installed, otp}); err != nil {
+		t.Fatalf("expected no error, got %s", err)
+	}
+
+	err := serverInfo.validateUserCredentials([]*UserCredential{missing})
+	if err == nil || !strings.Contains(err.Error(), `password hashing algorithm "bcrypt" does not exist on the server`) {
+		t.Fatalf("expected an error about the bcrypt provider, got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+)
+
+func (keycloakClient *KeycloakClient) GetUserFederatedIdentities(ctx context.Context, realmId, userId string) (FederatedIdentities, error) {
+	var federatedIdentities FederatedIdentities
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity", realmId, userId), &federatedIdentities, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return federatedIdentities, nil
+}
+
+// GetUserFederatedIdentity returns the link of a user to an identity provider, or nil if the user isn't linked to it
+func (keycloakClient *KeycloakClient) GetUserFederatedIdentity(ctx context.Context, realmId, userId, identityProvider string) (*FederatedIdentity, error) {
+	federatedIdentities, err := keycloakClient.GetUserFederatedIdentities(ctx, realmId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, federatedIdentity := range federatedIdentities {
+		if federatedIdentity.IdentityProvider == identityProvider {
+			return federatedIdentity, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (keycloakClient *KeycloakClient) NewUserFederatedIdentity(ctx context.Context, realmId, userId string, federatedIdentity *FederatedIdentity) error {
+	_, _, err := keycloakClient.post(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", realmId, userId, federatedIdentity.IdentityProvider), federatedIdentity)
+
+	return err
+}
+
+func (keycloakClient *KeycloakClient) DeleteUserFederatedIdentity(ctx context.Context, realmId, userId, identityProvider string) error {
+	return keycloakClient.delete(ctx, fmt.Sprintf("/realms/%s/users/%s/federated-identity/%s", realmId, userId, identityProvider), nil)
+}
+
+// updateUserFederatedIdentities links a user to exactly the given identity providers. Links that didn't change are
+// left untouched, so they keep their tokens.
+func (keycloakClient *KeycloakClient) updateUserFederatedIdentities(ctx context.Context, realmId, userId string, federatedIdentities FederatedIdentities) error {
+	currentFederatedIdentities, err := keycloakClient.GetUserFederatedIdentities(ctx, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	current := map[string]*FederatedIdentity{}
+	for _, federatedIdentity := range currentFederatedIdentities {
+		current[federatedIdentity.IdentityProvider] = federatedIdentity
+	}
+
+	desired := map[string]*FederatedIdentity{}
+	for _, federatedIdentity := range federatedIdentities {
+		desired[federatedIdentity.IdentityProvider] = federatedIdentity
+	}
+
+	for identityProvider, federatedIdentity := range current {
+		if d, ok := desired[identityProvider]; ok && *d == *federatedIdentity {
+			continue
+		}
+
+		err = keycloakClient.DeleteUserFederatedIdentity(ctx, realmId, userId, identityProvider)
+		if err != nil {
+			return err
+		}
+	}
+
+	for identityProvider, federatedIdentity := range desired {
+		if c, ok := current[identityProvider]; ok && *c == *federatedIdentity {
+			continue
+		}
+
+		err = keycloakClient.NewUserFederatedIdentity(ctx, realmId, userId, federatedIdentity)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
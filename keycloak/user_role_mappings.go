@@ -3,6 +3,7 @@ package keycloak
 import (
 	"context"
 	"fmt"
+	"net/url"
 )
 
 func (keycloakClient *KeycloakClient) GetUserRoleMappings(ctx context.Context, realmId string, userId string) (*RoleMapping, error) {
@@ -38,3 +39,13 @@ func (keycloakClient *KeycloakClient) RemoveClientRolesFromUser(ctx context.Cont
 
 	return err
 }
+
+// GetRealmRoleMembers returns the users that have a realm role directly assigned
+func (keycloakClient *KeycloakClient) GetRealmRoleMembers(ctx context.Context, realmId, roleName string) ([]*User, error) {
+	return getPaginatedUsers(ctx, keycloakClient, fmt.Sprintf("/realms/%s/roles/%s/users", realmId, url.PathEscape(roleName)), realmId, nil)
+}
+
+// GetClientRoleMembers returns the users that have a client role directly assigned
+func (keycloakClient *KeycloakClient) GetClientRoleMembers(ctx context.Context, realmId, clientId, roleName string) ([]*User, error) {
+	return getPaginatedUsers(ctx, keycloakClient, fmt.Sprintf("/realms/%s/clients/%s/roles/%s/users", realmId, clientId, url.PathEscape(roleName)), realmId, nil)
+}
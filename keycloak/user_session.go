@@ -0,0 +1,138 @@
+package keycloak
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const userSessionsPageSize = 100
+
+// UserSession is an active or offline session of a user. Clients maps the id of each client of the session to its client_id.
+type UserSession struct {
+	Id         string            `json:"id"`
+	Username   string            `json:"username"`
+	UserId     string            `json:"userId"`
+	IpAddress  string            `json:"ipAddress"`
+	Start      int64             `json:"start"`
+	LastAccess int64             `json:"lastAccess"`
+	RememberMe bool              `json:"rememberMe"`
+	Clients    map[string]string `json:"clients"`
+}
+
+// ClientSessionStats is the number of active and offline sessions of a client. Keycloak serializes the counts as strings.
+type ClientSessionStats struct {
+	Id       string `json:"id"`
+	ClientId string `json:"clientId"`
+	Active   int
+	Offline  int
+}
+
+type clientSessionStatsRepresentation struct {
+	Id       string `json:"id"`
+	ClientId string `json:"clientId"`
+	Active   string `json:"active"`
+	Offline  string `json:"offline"`
+}
+
+// getPaginatedUserSessions fetches all the sessions returned by an endpoint that supports the first and max query parameters
+func getPaginatedUserSessions(ctx context.Context, keycloakClient *KeycloakClient, path string) ([]*UserSession, error) {
+	var sessions []*UserSession
+
+	values := url.Values{}
+	values.Set("max", strconv.Itoa(userSessionsPageSize))
+
+	for {
+		values.Set("first", strconv.Itoa(len(sessions)))
+
+		var page []*UserSession
+		err := keycloakClient.getWithQuery(ctx, path, &page, values)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, page...)
+
+		if len(page) < userSessionsPageSize {
+			break
+		}
+	}
+
+	return sessions, nil
+}
+
+func (keycloakClient *KeycloakClient) GetClientUserSessions(ctx context.Context, realmId, clientId string) ([]*UserSession, error) {
+	return getPaginatedUserSessions(ctx, keycloakClient, fmt.Sprintf("/realms/%s/clients/%s/user-sessions", realmId, clientId))
+}
+
+func (keycloakClient *KeycloakClient) GetClientOfflineSessions(ctx context.Context, realmId, clientId string) ([]*UserSession, error) {
+	return getPaginatedUserSessions(ctx, keycloakClient, fmt.Sprintf("/realms/%s/clients/%s/offline-sessions", realmId, clientId))
+}
+
+func (keycloakClient *KeycloakClient) GetUserSessions(ctx context.Context, realmId, userId string) ([]*UserSession, error) {
+	var sessions []*UserSession
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/sessions", realmId, userId), &sessions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// GetUserOfflineSessions returns the offline sessions of a user for a single client, identified by its id
+func (keycloakClient *KeycloakClient) GetUserOfflineSessions(ctx context.Context, realmId, userId, clientId string) ([]*UserSession, error) {
+	var sessions []*UserSession
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/users/%s/offline-sessions/%s", realmId, userId, clientId), &sessions, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+// GetClientSessionStats returns the session counts of every client of a realm that has at least one active or offline session
+func (keycloakClient *KeycloakClient) GetClientSessionStats(ctx context.Context, realmId string) ([]*ClientSessionStats, error) {
+	var representations []*clientSessionStatsRepresentation
+
+	err := keycloakClient.get(ctx, fmt.Sprintf("/realms/%s/client-session-stats", realmId), &representations, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*ClientSessionStats, 0, len(representations))
+	for _, representation := range representations {
+		active, err := parseSessionCount(representation.Active)
+		if err != nil {
+			return nil, err
+		}
+		offline, err := parseSessionCount(representation.Offline)
+		if err != nil {
+			return nil, err
+		}
+
+		stats = append(stats, &ClientSessionStats{
+			Id:       representation.Id,
+			ClientId: representation.ClientId,
+			Active:   active,
+			Offline:  offline,
+		})
+	}
+
+	return stats, nil
+}
+
+func parseSessionCount(count string) (int, error) {
+	if count == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.Atoi(count)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse session count \"%s\": %v", count, err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,67 @@
+package keycloak
+
+import "testing"
+
+func TestUsersQueryValues(t *testing.T) {
+	exact := true
+	enabled := false
+
+	values := (&UsersQuery{
+		Email:    "example.com",
+		Exact:    &exact,
+		Enabled:  &enabled,
+		IdpAlias: "google",
+		Attributes: map[string]string{
+			"location":   "berlin",
+			"department": "finance",
+		},
+	}).values()
+
+	expected := map[string]string{
+		"briefRepresentation": "false",
+		"email":               "example.com",
+		"exact":               "true",
+		"enabled":             "false",
+		"idpAlias":            "google",
+		"q":                   "department:finance location:berlin",
+	}
+
+	if len(values) != len(expected) {
+		t.Fatalf("expected %d query parameters, got %v", len(expected), values)
+	}
+
+	for key, value := range expected {
+		if values.Get(key) != value {
+			t.Errorf("expected query parameter %s to be %q, got %q", key, value, values.Get(key))
+		}
+	}
+}
+
+func TestUsersQueryValidate(t *testing.T) {
+	exact := true
+	enabled := true
+
+	tests := map[string]struct {
+		query *UsersQuery
+		valid bool
+	}{
+		"filters":                      {&UsersQuery{Username: "john", Exact: &exact, Attributes: map[string]string{"department": "finance"}}, true},
+		"search with enabled":          {&UsersQuery{Search: "john", Enabled: &enabled}, true},
+		"search with username":         {&UsersQuery{Search: "john", Username: "john"}, false},
+		"search with exact":            {&UsersQuery{Search: "john", Exact: &exact}, false},
+		"search with attributes":       {&UsersQuery{Search: "john", Attributes: map[string]string{"department": "finance"}}, false},
+		"attribute value with a space": {&UsersQuery{Attributes: map[string]string{"department": "human resources"}}, false},
+		"attribute value with a colon": {&UsersQuery{Attributes: map[string]string{"url": "https://example.com"}}, false},
+		"attribute name with a colon":  {&UsersQuery{Attributes: map[string]string{"a:b": "c"}}, false},
+	}
+
+	for name, test := range tests {
+		err := test.query.validate()
+		if test.valid && err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
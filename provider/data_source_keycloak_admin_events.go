@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakAdminEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakAdminEventsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"operation_types": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Only return admin events of these operation types: CREATE, UPDATE, DELETE or ACTION.",
+			},
+			"resource_types": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Only return admin events of these resource types, for example USER or CLIENT.",
+			},
+			"resource_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return admin events of resources with this path, * can be used as wildcard.",
+			},
+			"auth_realm_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return admin events done by users of the realm with this id.",
+			},
+			"auth_client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return admin events done through the client with this id.",
+			},
+			"auth_user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return admin events done by the user with this id.",
+			},
+			"auth_ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return admin events done from this IP address.",
+			},
+			"date_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: eventsDateValidation,
+				Description:  "Only return admin events from this date on, in the format yyyy-MM-dd.",
+			},
+			"date_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: eventsDateValidation,
+				Description:  "Only return admin events up to this date, in the format yyyy-MM-dd.",
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of admin events to return, 0 returns all matching admin events.",
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operation_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"representation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auth_realm_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auth_client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auth_user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"auth_ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakAdminEventsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	query := &keycloak.AdminEventsQuery{
+		OperationTypes: interfaceSliceToStringSlice(data.Get("operation_types").(*schema.Set).List()),
+		ResourceTypes:  interfaceSliceToStringSlice(data.Get("resource_types").(*schema.Set).List()),
+		ResourcePath:   data.Get("resource_path").(string),
+		AuthRealm:      data.Get("auth_realm_id").(string),
+		AuthClient:     data.Get("auth_client_id").(string),
+		AuthUser:       data.Get("auth_user_id").(string),
+		AuthIpAddress:  data.Get("auth_ip_address").(string),
+		DateFrom:       data.Get("date_from").(string),
+		DateTo:         data.Get("date_to").(string),
+	}
+
+	adminEvents, err := keycloakClient.GetRealmAdminEvents(ctx, realmId, query, data.Get("max_results").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	adminEventsData := make([]interface{}, 0, len(adminEvents))
+	for _, adminEvent := range adminEvents {
+		adminEventsData = append(adminEventsData, map[string]interface{}{
+			"id":              adminEvent.Id,
+			"time":            formatEventTime(adminEvent.Time),
+			"operation_type":  adminEvent.OperationType,
+			"resource_type":   adminEvent.ResourceType,
+			"resource_path":   adminEvent.ResourcePath,
+			"representation":  adminEvent.Representation,
+			"error":           adminEvent.Error,
+			"auth_realm_id":   adminEvent.AuthDetails.RealmId,
+			"auth_client_id":  adminEvent.AuthDetails.ClientId,
+			"auth_user_id":    adminEvent.AuthDetails.UserId,
+			"auth_ip_address": adminEvent.AuthDetails.IpAddress,
+		})
+	}
+
+	data.SetId(realmId)
+	data.Set("events", adminEventsData)
+
+	return nil
+}
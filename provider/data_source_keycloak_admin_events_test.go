@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakDataSourceAdminEvents_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_admin_events.role_created"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakAdminEvents_basic(realmName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "events.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.operation_type", "CREATE"),
+					resource.TestCheckResourceAttr(dataSourceName, "events.0.resource_type", "REALM_ROLE"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.0.time"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "events.0.auth_user_id"),
+					resource.TestCheckResourceAttr("data.keycloak_admin_events.deletes", "events.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakAdminEvents_basic(realm, role string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_events" "events" {
+	realm_id                     = keycloak_realm.realm.id
+	admin_events_enabled         = true
+	admin_events_details_enabled = true
+}
+
+resource "keycloak_role" "role" {
+	realm_id = keycloak_realm.realm.id
+	name     = "%s"
+
+	depends_on = [keycloak_realm_events.events]
+}
+
+data "keycloak_admin_events" "role_created" {
+	realm_id        = keycloak_realm.realm.id
+	operation_types = ["CREATE"]
+	resource_types  = ["REALM_ROLE"]
+	max_results     = 10
+
+	depends_on = [keycloak_role.role]
+}
+
+data "keycloak_admin_events" "deletes" {
+	realm_id        = keycloak_realm.realm.id
+	operation_types = ["DELETE"]
+
+	depends_on = [keycloak_role.role]
+}
+	`, realm, role)
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakClientSessions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakClientSessionsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sessions":         userSessionsSchema(),
+			"offline_sessions": userSessionsSchema(),
+			"session_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"offline_session_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func userSessionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"user_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"username": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ip_address": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"start": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"last_access": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"remember_me": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				"clients": {
+					Type:     schema.TypeMap,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func flattenUserSessions(sessions []*keycloak.UserSession) []interface{} {
+	sessionsData := make([]interface{}, 0, len(sessions))
+	for _, session := range sessions {
+		sessionsData = append(sessionsData, map[string]interface{}{
+			"id":          session.Id,
+			"user_id":     session.UserId,
+			"username":    session.Username,
+			"ip_address":  session.IpAddress,
+			"start":       formatEventTime(session.Start),
+			"last_access": formatEventTime(session.LastAccess),
+			"remember_me": session.RememberMe,
+			"clients":     session.Clients,
+		})
+	}
+
+	return sessionsData
+}
+
+func dataSourceKeycloakClientSessionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	sessions, err := keycloakClient.GetClientUserSessions(ctx, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	offlineSessions, err := keycloakClient.GetClientOfflineSessions(ctx, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.Set("sessions", flattenUserSessions(sessions))
+	data.Set("offline_sessions", flattenUserSessions(offlineSessions))
+	data.Set("session_count", len(sessions))
+	data.Set("offline_session_count", len(offlineSessions))
+	data.SetId(realmId + "/" + clientId)
+
+	return nil
+}
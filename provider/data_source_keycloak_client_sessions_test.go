@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakDataSourceClientSessions_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	password := "My password"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakSessions_basic(username, password, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_client_sessions.sessions", "session_count", "0"),
+					resource.TestCheckResourceAttr("data.keycloak_client_sessions.sessions", "offline_session_count", "0"),
+					testAccKeycloakUserOfflineLogin(username, password, clientId),
+				),
+			},
+			{
+				Config: testDataSourceKeycloakSessions_basic(username, password, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_client_sessions.sessions", "session_count", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_client_sessions.sessions", "sessions.0.username", username),
+					resource.TestCheckResourceAttrPair("data.keycloak_client_sessions.sessions", "sessions.0.user_id", "keycloak_user.user", "id"),
+					resource.TestCheckResourceAttrSet("data.keycloak_client_sessions.sessions", "sessions.0.ip_address"),
+					resource.TestCheckResourceAttrSet("data.keycloak_client_sessions.sessions", "sessions.0.start"),
+					resource.TestCheckResourceAttrSet("data.keycloak_client_sessions.sessions", "sessions.0.last_access"),
+					resource.TestCheckResourceAttr("data.keycloak_client_sessions.sessions", "offline_session_count", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_client_sessions.sessions", "offline_sessions.0.username", username),
+				),
+			},
+		},
+	})
+}
+
+// testDataSourceKeycloakSessions_basic reads the sessions of a user logged in with testAccKeycloakUserOfflineLogin
+func testDataSourceKeycloakSessions_basic(username, password, clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                     = data.keycloak_realm.realm.id
+	client_id                    = "%s"
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	initial_password {
+		value     = "%s"
+		temporary = false
+	}
+}
+
+data "keycloak_client_sessions" "sessions" {
+	realm_id  = data.keycloak_realm.realm.id
+	client_id = keycloak_openid_client.client.id
+
+	depends_on = [keycloak_user.user]
+}
+
+data "keycloak_user_sessions" "sessions" {
+	realm_id           = data.keycloak_realm.realm.id
+	user_id            = keycloak_user.user.id
+	offline_client_ids = [keycloak_openid_client.client.id]
+}
+
+data "keycloak_realm_client_session_stats" "stats" {
+	realm_id = data.keycloak_realm.realm.id
+
+	depends_on = [keycloak_openid_client.client, keycloak_user.user]
+}
+	`, testAccRealm.Realm, clientId, username, password)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+var eventsDateValidation = validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in the format yyyy-MM-dd")
+
+func dataSourceKeycloakEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakEventsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"types": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Only return events of these types, for example LOGIN or LOGIN_ERROR.",
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return events of the client with this client id.",
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return events of the user with this id.",
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return events from this IP address.",
+			},
+			"date_from": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: eventsDateValidation,
+				Description:  "Only return events from this date on, in the format yyyy-MM-dd.",
+			},
+			"date_to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: eventsDateValidation,
+				Description:  "Only return events up to this date, in the format yyyy-MM-dd.",
+			},
+			"max_results": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of events to return, 0 returns all matching events.",
+			},
+			"events": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"session_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"details": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func formatEventTime(milliseconds int64) string {
+	return time.UnixMilli(milliseconds).UTC().Format(time.RFC3339)
+}
+
+func dataSourceKeycloakEventsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	query := &keycloak.EventsQuery{
+		Types:     interfaceSliceToStringSlice(data.Get("types").(*schema.Set).List()),
+		Client:    data.Get("client_id").(string),
+		User:      data.Get("user_id").(string),
+		IpAddress: data.Get("ip_address").(string),
+		DateFrom:  data.Get("date_from").(string),
+		DateTo:    data.Get("date_to").(string),
+	}
+
+	events, err := keycloakClient.GetRealmEvents(ctx, realmId, query, data.Get("max_results").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	eventsData := make([]interface{}, 0, len(events))
+	for _, event := range events {
+		eventsData = append(eventsData, map[string]interface{}{
+			"id":         event.Id,
+			"time":       formatEventTime(event.Time),
+			"type":       event.Type,
+			"client_id":  event.ClientId,
+			"user_id":    event.UserId,
+			"session_id": event.SessionId,
+			"ip_address": event.IpAddress,
+			"error":      event.Error,
+			"details":    event.Details,
+		})
+	}
+
+	data.SetId(realmId)
+	data.Set("events", eventsData)
+
+	return nil
+}
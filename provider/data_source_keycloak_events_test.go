@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakDataSourceEvents_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_events.login_errors"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakEvents_basic(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", realmName),
+					resource.TestCheckResourceAttr(dataSourceName, "events.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceEvents_invalidDate(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakEvents_dateFrom(realmName, "01/02/2024"),
+				ExpectError: regexp.MustCompile("must be a date in the format yyyy-MM-dd"),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakEvents_basic(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_events" "events" {
+	realm_id            = keycloak_realm.realm.id
+	events_enabled      = true
+	enabled_event_types = ["LOGIN", "LOGIN_ERROR"]
+}
+
+data "keycloak_events" "login_errors" {
+	realm_id   = keycloak_realm.realm.id
+	types      = ["LOGIN_ERROR"]
+	user_id    = "00000000-0000-0000-0000-000000000000"
+	client_id  = "account"
+	ip_address = "127.0.0.1"
+	date_from  = "2024-01-01"
+	date_to    = "2099-12-31"
+
+	depends_on = [keycloak_realm_events.events]
+}
+	`, realm)
+}
+
+func testDataSourceKeycloakEvents_dateFrom(realm, dateFrom string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_events" "events" {
+	realm_id  = keycloak_realm.realm.id
+	date_from = "%s"
+}
+	`, realm, dateFrom)
+}
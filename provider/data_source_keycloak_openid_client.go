@@ -259,6 +259,30 @@ func dataSourceKeycloakOpenidClient() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"ciba_grant_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"ciba_backchannel_token_delivery_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ciba_backchannel_client_notification_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ciba_backchannel_auth_request_signing_alg": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"require_pushed_authorization_requests": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"request_object_required": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"always_display_in_console": {
 				Type:     schema.TypeBool,
 				Optional: true,
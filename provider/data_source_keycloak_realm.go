@@ -506,6 +506,22 @@ func dataSourceKeycloakRealm() *schema.Resource {
 				Description: "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
 				Computed:    true,
 			},
+			"password_policy_rule": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 
 			//flow bindings
 			"browser_flow": {
@@ -595,6 +611,38 @@ func dataSourceKeycloakRealm() *schema.Resource {
 					Schema: webAuthnSchema,
 				},
 			},
+
+			// CIBA Policy
+			"ciba_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backchannel_token_delivery_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expires_in": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"interval": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"auth_requested_user_hint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			// Pushed Authorization Requests
+			"par_request_uri_lifespan": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -614,6 +662,7 @@ func dataSourceKeycloakRealmRead(ctx context.Context, data *schema.ResourceData,
 	}
 
 	setRealmData(data, realm, keycloakVersion)
+	data.Set("password_policy_rule", flattenPasswordPolicyRules(realm.PasswordPolicy))
 
 	return nil
 }
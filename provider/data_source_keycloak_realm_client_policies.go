@@ -0,0 +1,206 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakRealmClientPolicyComponentSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakRealmClientPolicyProfileSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"description": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"executor": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     dataSourceKeycloakRealmClientPolicyComponentSchema(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakRealmClientPolicies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRealmClientPoliciesRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"global_profiles": dataSourceKeycloakRealmClientPolicyProfileSchema(),
+			"profiles":        dataSourceKeycloakRealmClientPolicyProfileSchema(),
+			"policies": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"profiles": {
+							Type:     schema.TypeList,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"condition": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     dataSourceKeycloakRealmClientPolicyComponentSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenRealmClientPolicyConfiguration renders every configuration value as a string, json encoding anything that is
+// not already a string so that lists, objects, numbers and booleans survive the round trip.
+func flattenRealmClientPolicyConfiguration(configuration map[string]interface{}) (map[string]interface{}, error) {
+	flattened := make(map[string]interface{}, len(configuration))
+	for key, value := range configuration {
+		if s, ok := value.(string); ok {
+			flattened[key] = s
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		flattened[key] = string(encoded)
+	}
+
+	return flattened, nil
+}
+
+func flattenRealmClientPolicyProfiles(profiles []keycloak.RealmClientPolicyProfile) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(profiles))
+	for _, profile := range profiles {
+		executors := make([]interface{}, 0, len(profile.Executors))
+		for _, executor := range profile.Executors {
+			configuration, err := flattenRealmClientPolicyConfiguration(executor.Configuration)
+			if err != nil {
+				return nil, err
+			}
+
+			executors = append(executors, map[string]interface{}{
+				"name":          executor.Name,
+				"configuration": configuration,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":        profile.Name,
+			"description": profile.Description,
+			"executor":    executors,
+		})
+	}
+
+	return result, nil
+}
+
+func flattenRealmClientPolicyProfilePolicies(policies []keycloak.RealmClientPolicyProfilePolicy) ([]interface{}, error) {
+	result := make([]interface{}, 0, len(policies))
+	for _, policy := range policies {
+		conditions := make([]interface{}, 0, len(policy.Conditions))
+		for _, condition := range policy.Conditions {
+			configuration, err := flattenRealmClientPolicyConfiguration(condition.Configuration)
+			if err != nil {
+				return nil, err
+			}
+
+			conditions = append(conditions, map[string]interface{}{
+				"name":          condition.Name,
+				"configuration": configuration,
+			})
+		}
+
+		result = append(result, map[string]interface{}{
+			"name":        policy.Name,
+			"description": policy.Description,
+			"enabled":     policy.Enabled,
+			"profiles":    policy.Profiles,
+			"condition":   conditions,
+		})
+	}
+
+	return result, nil
+}
+
+func dataSourceKeycloakRealmClientPoliciesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	profiles, err := keycloakClient.GetAllRealmClientPolicyProfiles(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies, err := keycloakClient.GetAllRealmClientPolicyProfilePolices(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	globalProfiles, err := flattenRealmClientPolicyProfiles(profiles.GlobalProfiles)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	realmProfiles, err := flattenRealmClientPolicyProfiles(profiles.Profiles)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	realmPolicies, err := flattenRealmClientPolicyProfilePolicies(policies.Policies)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+	data.Set("global_profiles", globalProfiles)
+	data.Set("profiles", realmProfiles)
+	data.Set("policies", realmPolicies)
+
+	return nil
+}
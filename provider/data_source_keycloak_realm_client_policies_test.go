@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakDataSourceRealmClientPolicies_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_realm_client_policies.policies"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakRealmClientPolicies_basic(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "realm_id", realmName),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "global_profiles.*", map[string]string{
+						"name": "fapi-1-baseline",
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "profiles.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "profiles.0.name", "test-profile"),
+					resource.TestCheckResourceAttr(dataSourceName, "profiles.0.executor.0.name", "pkce-enforcer"),
+					resource.TestCheckResourceAttr(dataSourceName, "profiles.0.executor.0.configuration.auto-configure", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.name", "test-policy"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.profiles.0", "fapi-1-baseline"),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.0.condition.0.name", "client-updater-context"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakRealmClientPolicies_basic(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_policy_profile" "profile" {
+	realm_id = keycloak_realm.realm.realm
+	name     = "test-profile"
+
+	executor {
+		name = "pkce-enforcer"
+		configuration = {
+			auto-configure = "true"
+		}
+	}
+}
+
+resource "keycloak_realm_client_policy_profile_policy" "policy" {
+	realm_id = keycloak_realm.realm.realm
+	name     = "test-policy"
+	profiles = ["fapi-1-baseline"]
+
+	condition {
+		name = "client-updater-context"
+		configuration = {
+			update-client-source = jsonencode(["ByInitialAccessToken"])
+		}
+	}
+}
+
+data "keycloak_realm_client_policies" "policies" {
+	realm_id = keycloak_realm.realm.realm
+
+	depends_on = [
+		keycloak_realm_client_policy_profile.profile,
+		keycloak_realm_client_policy_profile_policy.policy,
+	]
+}
+	`, realm)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakRealmClientSessionStats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakRealmClientSessionStatsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"stats": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"active": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"offline": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			// clients without any session are omitted from the stats, so they are summed up here for convenience
+			"active_sessions": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"offline_sessions": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakRealmClientSessionStatsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	stats, err := keycloakClient.GetClientSessionStats(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	statsData := make([]interface{}, 0, len(stats))
+	activeSessions, offlineSessions := 0, 0
+	for _, clientStats := range stats {
+		statsData = append(statsData, map[string]interface{}{
+			"id":        clientStats.Id,
+			"client_id": clientStats.ClientId,
+			"active":    clientStats.Active,
+			"offline":   clientStats.Offline,
+		})
+
+		activeSessions += clientStats.Active
+		offlineSessions += clientStats.Offline
+	}
+
+	data.Set("stats", statsData)
+	data.Set("active_sessions", activeSessions)
+	data.Set("offline_sessions", offlineSessions)
+	data.SetId(realmId)
+
+	return nil
+}
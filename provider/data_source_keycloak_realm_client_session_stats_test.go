@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakDataSourceRealmClientSessionStats_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	password := "My password"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakSessions_basic(username, password, clientId),
+				Check:  testAccKeycloakUserOfflineLogin(username, password, clientId),
+			},
+			{
+				Config: testDataSourceKeycloakSessions_basic(username, password, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.keycloak_realm_client_session_stats.stats", "stats.*", map[string]string{
+						"client_id": clientId,
+						"active":    "1",
+						"offline":   "1",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -2,11 +2,15 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
@@ -29,6 +33,23 @@ func dataSourceKeycloakRealmKeys() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Optional: true,
 			},
+			"use": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"sig", "enc"}, false),
+				},
+				Optional: true,
+			},
+			"min_provider_priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"jwks": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON web key set with the public keys that match the filter criteria.",
+			},
 			"keys": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -74,6 +95,11 @@ func dataSourceKeycloakRealmKeys() *schema.Resource {
 							Computed: true,
 							Optional: true,
 						},
+						"use": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -109,6 +135,9 @@ func flattenRealmKeys(realmKeys []keycloak.Key) []map[string]interface{} {
 		if key.Type != nil {
 			element["type"] = key.Type
 		}
+		if key.Use != nil {
+			element["use"] = strings.ToLower(*key.Use)
+		}
 
 		keyMap = append(keyMap, element)
 	}
@@ -123,6 +152,26 @@ func setRealmKeysData(data *schema.ResourceData, keys *keycloak.Keys) error {
 		return fmt.Errorf("could not set 'keys' with values '%+v'\n%+v", keys.Keys, err)
 	}
 
+	// disabled keys are neither used to sign nor to verify tokens, they are only published when asked for explicitly
+	jwksKeys := keys.Keys
+	if filterStatus, ok := data.GetOk("status"); !ok || !filterStatus.(*schema.Set).Contains("DISABLED") {
+		jwksKeys = slices.DeleteFunc(slices.Clone(jwksKeys), func(key keycloak.Key) bool {
+			return StringValue(key.Status) == "DISABLED"
+		})
+	}
+
+	jwks, err := keycloak.NewJSONWebKeySet(jwksKeys)
+	if err != nil {
+		return err
+	}
+
+	jwksJson, err := json.Marshal(jwks)
+	if err != nil {
+		return err
+	}
+
+	data.Set("jwks", string(jwksJson))
+
 	return nil
 }
 
@@ -144,6 +193,14 @@ func dataSourceKeycloakRealmKeysRead(ctx context.Context, data *schema.ResourceD
 		keys.Keys = filterKeys(keys.Keys, "algorithms", filterAlgorithm.(*schema.Set))
 	}
 
+	if filterUse, ok := data.GetOk("use"); ok {
+		keys.Keys = filterKeys(keys.Keys, "use", filterUse.(*schema.Set))
+	}
+
+	if minProviderPriority, ok := data.GetOk("min_provider_priority"); ok {
+		keys.Keys = filterKeysByMinProviderPriority(keys.Keys, minProviderPriority.(int))
+	}
+
 	if len(keys.Keys) == 0 {
 		return diag.Diagnostics{{
 			Summary:  "Your query returned no results. Please change your search criteria and try again.",
@@ -164,6 +221,8 @@ func filterKeys(allValues []keycloak.Key, filterAttribute string, allowedValues
 			keyValue = StringValue(key.Status)
 		case "algorithms":
 			keyValue = StringValue(key.Algorithm)
+		case "use":
+			keyValue = strings.ToLower(StringValue(key.Use))
 		}
 
 		if Contains(allowedValues.List(), keyValue) {
@@ -174,6 +233,18 @@ func filterKeys(allValues []keycloak.Key, filterAttribute string, allowedValues
 	return result
 }
 
+func filterKeysByMinProviderPriority(allValues []keycloak.Key, minProviderPriority int) []keycloak.Key {
+	var result []keycloak.Key
+
+	for _, key := range allValues {
+		if key.ProviderPriority != nil && *key.ProviderPriority >= minProviderPriority {
+			result = append(result, key)
+		}
+	}
+
+	return result
+}
+
 // Contains checks if the array contains the value
 func Contains(array []interface{}, value interface{}) bool {
 	for _, element := range array {
@@ -1,11 +1,15 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
 func TestAccKeycloakDataSourceRealmKeys_basic(t *testing.T) {
@@ -40,6 +44,73 @@ func TestAccKeycloakDataSourceRealmKeys_filterByAlgorithms(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakDataSourceRealmKeys_jwks(t *testing.T) {
+	t.Parallel()
+	dataSourceName := "data.keycloak_realm_keys.test_keys"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakRealmKeysConfig_jwks(),
+				Check:  testKeycloakRealmKeysCheck_jwks(dataSourceName),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceRealmKeys_jwksDisabledKeys(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_realm_keys.test_keys"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakRealmKeysConfig_disabledKey(realmName, ""),
+				Check:  testKeycloakRealmKeysCheck_jwksDisabledKeys(dataSourceName, false),
+			},
+			{
+				Config: testAccKeycloakRealmKeysConfig_disabledKey(realmName, `status = ["ACTIVE", "DISABLED"]`),
+				Check:  testKeycloakRealmKeysCheck_jwksDisabledKeys(dataSourceName, true),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceRealmKeys_generatedKeystores(t *testing.T) {
+	t.Parallel()
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_23)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	dataSourceName := "data.keycloak_realm_keys.test_keys"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeycloakRealmKeysConfig_generatedKeystores(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "keys.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "keys.*", map[string]string{
+						"algorithm": "EdDSA",
+						"type":      "OKP",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "keys.*", map[string]string{
+						"algorithm": "RSA-OAEP-256",
+						"type":      "RSA",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func getRealmKeysUsingState(state *terraform.State, resourceName string) (*terraform.ResourceState, error) {
 	rs, ok := state.RootModule().Resources[resourceName]
 	if !ok {
@@ -84,6 +155,78 @@ func testKeycloakRealmKeysCheck_filterByAlgorithms(dataSourceName string) resour
 	}
 }
 
+func testKeycloakRealmKeysCheck_jwks(dataSourceName string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		datasourceState, err := getRealmKeysUsingState(state, dataSourceName)
+		if err != nil {
+			return err
+		}
+
+		var jwks keycloak.JSONWebKeySet
+		err = json.Unmarshal([]byte(datasourceState.Primary.Attributes["jwks"]), &jwks)
+		if err != nil {
+			return fmt.Errorf("could not parse jwks: %v", err)
+		}
+
+		if len(jwks.Keys) == 0 {
+			return fmt.Errorf("expected jwks to contain at least one key")
+		}
+
+		for _, jwk := range jwks.Keys {
+			if jwk.Use != "sig" {
+				return fmt.Errorf("filtering by use returned key %s with use '%s'", jwk.Kid, jwk.Use)
+			}
+			if jwk.Kty == "RSA" && (jwk.N == "" || jwk.E == "" || len(jwk.X5c) == 0) {
+				return fmt.Errorf("expected RSA key %s to have a modulus, an exponent and a certificate chain", jwk.Kid)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testKeycloakRealmKeysCheck_jwksDisabledKeys checks that the disabled keys listed in keys are only part of the jwks
+// when they were asked for
+func testKeycloakRealmKeysCheck_jwksDisabledKeys(dataSourceName string, expectDisabledKeys bool) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		datasourceState, err := getRealmKeysUsingState(state, dataSourceName)
+		if err != nil {
+			return err
+		}
+
+		var jwks keycloak.JSONWebKeySet
+		err = json.Unmarshal([]byte(datasourceState.Primary.Attributes["jwks"]), &jwks)
+		if err != nil {
+			return fmt.Errorf("could not parse jwks: %v", err)
+		}
+
+		kids := map[string]bool{}
+		for _, jwk := range jwks.Keys {
+			kids[jwk.Kid] = true
+		}
+
+		disabledKeys := 0
+		keyCount, _ := strconv.Atoi(datasourceState.Primary.Attributes["keys.#"])
+		for i := range keyCount {
+			if datasourceState.Primary.Attributes[fmt.Sprintf("keys.%d.status", i)] != "DISABLED" {
+				continue
+			}
+			disabledKeys++
+
+			kid := datasourceState.Primary.Attributes[fmt.Sprintf("keys.%d.kid", i)]
+			if kids[kid] != expectDisabledKeys {
+				return fmt.Errorf("expected disabled key %s to be part of the jwks: %t", kid, expectDisabledKeys)
+			}
+		}
+
+		if disabledKeys == 0 {
+			return fmt.Errorf("expected the keys to contain a disabled key")
+		}
+
+		return nil
+	}
+}
+
 func testAccKeycloakRealmKeysConfig() string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -108,3 +251,72 @@ data "keycloak_realm_keys" "test_keys" {
 }
 `, testAccRealm.Realm)
 }
+
+func testAccKeycloakRealmKeysConfig_jwks() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_realm_keys" "test_keys" {
+	realm_id              = data.keycloak_realm.realm.id
+	use                   = ["sig"]
+	status                = ["ACTIVE"]
+	min_provider_priority = 0
+}
+`, testAccRealm.Realm)
+}
+
+func testAccKeycloakRealmKeysConfig_generatedKeystores(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_eddsa_generated" "eddsa" {
+	name               = "eddsa"
+	realm_id           = keycloak_realm.realm.id
+	elliptic_curve_key = "Ed25519"
+}
+
+resource "keycloak_realm_keystore_rsa_enc_generated" "rsa_enc" {
+	name      = "rsa-enc"
+	realm_id  = keycloak_realm.realm.id
+	algorithm = "RSA-OAEP-256"
+}
+
+data "keycloak_realm_keys" "test_keys" {
+	realm_id   = keycloak_realm.realm.id
+	algorithms = ["EdDSA", "RSA-OAEP-256"]
+
+	depends_on = [
+		keycloak_realm_keystore_eddsa_generated.eddsa,
+		keycloak_realm_keystore_rsa_enc_generated.rsa_enc,
+	]
+}
+`, realm)
+}
+
+func testAccKeycloakRealmKeysConfig_disabledKey(realm, statusFilter string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_keystore_rsa_generated" "disabled" {
+	name     = "disabled"
+	realm_id = keycloak_realm.realm.id
+	enabled  = false
+	active   = false
+}
+
+data "keycloak_realm_keys" "test_keys" {
+	realm_id = keycloak_realm.realm.id
+	%s
+
+	depends_on = [
+		keycloak_realm_keystore_rsa_generated.disabled,
+	]
+}
+`, realm, statusFilter)
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUserBruteForceStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUserBruteForceStatusRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the user is currently locked out by brute force detection.",
+			},
+			"num_failures": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_failure": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last login failure in RFC 3339 format, empty if the user has no recorded failures.",
+			},
+			"last_ip_failure": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUserBruteForceStatusRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	status, err := keycloakClient.GetUserBruteForceStatus(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lastFailure := ""
+	if status.LastFailure > 0 {
+		lastFailure = time.UnixMilli(status.LastFailure).UTC().Format(time.RFC3339)
+	}
+
+	data.SetId(realmId + "/" + userId)
+	data.Set("disabled", status.Disabled)
+	data.Set("num_failures", status.NumFailures)
+	data.Set("last_failure", lastFailure)
+	data.Set("last_ip_failure", status.LastIPFailure)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakDataSourceUserBruteForceStatus_basic(t *testing.T) {
+	t.Parallel()
+
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUserBruteForceStatus_basic(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.keycloak_user_brute_force_status.status", "user_id", "keycloak_user.user", "id"),
+					resource.TestCheckResourceAttr("data.keycloak_user_brute_force_status.status", "disabled", "false"),
+					resource.TestCheckResourceAttr("data.keycloak_user_brute_force_status.status", "num_failures", "0"),
+					resource.TestCheckResourceAttr("data.keycloak_user_brute_force_status.status", "last_failure", ""),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUserBruteForceStatus_basic(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+data "keycloak_user_brute_force_status" "status" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+}
+	`, testAccRealm.Realm, username)
+}
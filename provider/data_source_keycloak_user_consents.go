@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUserConsents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUserConsentsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"consents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"client_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"granted_client_scopes": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"created_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_updated_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"offline_token": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUserConsentsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	consents, err := keycloakClient.GetUserConsents(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	consentsData := make([]interface{}, 0, len(consents))
+	for _, consent := range consents {
+		// clients the user only holds an offline token for have no consent dates
+		createdDate, lastUpdatedDate := "", ""
+		if consent.CreatedDate != 0 {
+			createdDate = formatEventTime(consent.CreatedDate)
+		}
+		if consent.LastUpdatedDate != 0 {
+			lastUpdatedDate = formatEventTime(consent.LastUpdatedDate)
+		}
+
+		consentsData = append(consentsData, map[string]interface{}{
+			"client_id":             consent.ClientId,
+			"granted_client_scopes": consent.GrantedClientScopes,
+			"created_date":          createdDate,
+			"last_updated_date":     lastUpdatedDate,
+			"offline_token":         consent.HasOfflineToken(),
+		})
+	}
+
+	data.Set("consents", consentsData)
+	data.SetId(realmId + "/" + userId)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakDataSourceUserCredentials_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUserCredentials_basic(username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_user_credentials.credentials", "credentials.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_user_credentials.credentials", "credentials.0.type", "password"),
+					resource.TestCheckResourceAttrSet("data.keycloak_user_credentials.credentials", "credentials.0.id"),
+					resource.TestCheckResourceAttrSet("data.keycloak_user_credentials.credentials", "credentials.0.created_date"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUserCredentials_basic(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	initial_password {
+		value     = "My password"
+		temporary = false
+	}
+}
+
+data "keycloak_user_credentials" "credentials" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+}
+	`, testAccRealm.Realm, username)
+}
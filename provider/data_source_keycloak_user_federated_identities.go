@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUserFederatedIdentities() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUserFederatedIdentitiesRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"federated_identities": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identity_provider": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"federated_user_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"federated_username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceKeycloakUserFederatedIdentitiesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	federatedIdentities, err := keycloakClient.GetUserFederatedIdentities(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	federatedIdentitiesData := make([]interface{}, 0, len(federatedIdentities))
+	for _, federatedIdentity := range federatedIdentities {
+		federatedIdentitiesData = append(federatedIdentitiesData, map[string]interface{}{
+			"identity_provider":  federatedIdentity.IdentityProvider,
+			"federated_user_id":  federatedIdentity.UserId,
+			"federated_username": federatedIdentity.UserName,
+		})
+	}
+
+	data.Set("federated_identities", federatedIdentitiesData)
+	data.SetId(realmId + "/" + userId)
+
+	return nil
+}
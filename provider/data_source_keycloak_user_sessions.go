@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUserSessions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUserSessionsRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			// offline sessions can only be listed per client
+			"offline_client_ids": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"sessions":         userSessionsSchema(),
+			"offline_sessions": userSessionsSchema(),
+		},
+	}
+}
+
+func dataSourceKeycloakUserSessionsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	sessions, err := keycloakClient.GetUserSessions(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var offlineSessions []*keycloak.UserSession
+	for _, clientId := range interfaceSliceToStringSlice(data.Get("offline_client_ids").(*schema.Set).List()) {
+		clientOfflineSessions, err := keycloakClient.GetUserOfflineSessions(ctx, realmId, userId, clientId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		offlineSessions = append(offlineSessions, clientOfflineSessions...)
+	}
+
+	data.Set("sessions", flattenUserSessions(sessions))
+	data.Set("offline_sessions", flattenUserSessions(offlineSessions))
+	data.SetId(realmId + "/" + userId)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakDataSourceUserSessions_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	password := "My password"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakSessions_basic(username, password, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_user_sessions.sessions", "sessions.#", "0"),
+					resource.TestCheckResourceAttr("data.keycloak_user_sessions.sessions", "offline_sessions.#", "0"),
+					testAccKeycloakUserOfflineLogin(username, password, clientId),
+				),
+			},
+			{
+				Config: testDataSourceKeycloakSessions_basic(username, password, clientId),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_user_sessions.sessions", "sessions.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_user_sessions.sessions", "sessions.0.username", username),
+					resource.TestCheckResourceAttrSet("data.keycloak_user_sessions.sessions", "sessions.0.ip_address"),
+					resource.TestCheckResourceAttr("data.keycloak_user_sessions.sessions", "sessions.0.clients.%", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_user_sessions.sessions", "offline_sessions.#", "1"),
+				),
+			},
+		},
+	})
+}
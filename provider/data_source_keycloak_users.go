@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func dataSourceKeycloakUsers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceKeycloakUsersRead,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return users whose username, email, first name or last name contain this string. Can only be combined with enabled.",
+				// Keycloak ignores every other filter but enabled when searching
+				ConflictsWith: []string{"username", "email", "first_name", "last_name", "exact", "idp_alias", "attributes"},
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return users whose username contains this string, or is equal to it when exact is true.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return users whose email contains this string, or is equal to it when exact is true.",
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return users whose first name contains this string, or is equal to it when exact is true.",
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return users whose last name contains this string, or is equal to it when exact is true.",
+			},
+			"exact": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether username, email, first_name, last_name and attributes must match exactly.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return users that are enabled, or disabled when false.",
+			},
+			"idp_alias": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return users linked to the identity provider with this alias.",
+			},
+			"attributes": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Only return users that have all of these attribute values. Names and values can't contain spaces or colons.",
+			},
+			"include_groups": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to read the groups of every user, which needs an extra request per user.",
+			},
+			"include_roles": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to read the role mappings of every user, which needs an extra request per user.",
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"username": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email_verified": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"groups": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"realm_roles": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+						"client_roles": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"client": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"roles": {
+										Type:     schema.TypeSet,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getUsersQueryFromData(data *schema.ResourceData) *keycloak.UsersQuery {
+	query := &keycloak.UsersQuery{
+		Search:     data.Get("search").(string),
+		Username:   data.Get("username").(string),
+		Email:      data.Get("email").(string),
+		FirstName:  data.Get("first_name").(string),
+		LastName:   data.Get("last_name").(string),
+		IdpAlias:   data.Get("idp_alias").(string),
+		Attributes: map[string]string{},
+	}
+
+	if v, ok := data.GetOkExists("exact"); ok {
+		exact := v.(bool)
+		query.Exact = &exact
+	}
+
+	if v, ok := data.GetOkExists("enabled"); ok {
+		enabled := v.(bool)
+		query.Enabled = &enabled
+	}
+
+	for key, value := range data.Get("attributes").(map[string]interface{}) {
+		query.Attributes[key] = value.(string)
+	}
+
+	return query
+}
+
+func dataSourceKeycloakUsersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	includeGroups := data.Get("include_groups").(bool)
+	includeRoles := data.Get("include_roles").(bool)
+
+	users, err := keycloakClient.SearchUsers(ctx, realmId, getUsersQueryFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	usersData := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		attributes := map[string]string{}
+		for key, values := range user.Attributes {
+			attributes[key] = strings.Join(values, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		userData := map[string]interface{}{
+			"id":             user.Id,
+			"username":       user.Username,
+			"email":          user.Email,
+			"email_verified": user.EmailVerified,
+			"first_name":     user.FirstName,
+			"last_name":      user.LastName,
+			"enabled":        user.Enabled,
+			"attributes":     attributes,
+		}
+
+		if includeGroups {
+			groups, err := keycloakClient.GetUserGroups(ctx, realmId, user.Id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			var groupsData []interface{}
+			for _, group := range groups {
+				groupsData = append(groupsData, map[string]interface{}{
+					"id":   group.Id,
+					"path": group.Path,
+				})
+			}
+			userData["groups"] = groupsData
+		}
+
+		if includeRoles {
+			roleMappings, err := keycloakClient.GetUserRoleMappings(ctx, realmId, user.Id)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			var realmRoles []string
+			for _, role := range roleMappings.RealmMappings {
+				realmRoles = append(realmRoles, role.Name)
+			}
+
+			var clientRoles []interface{}
+			for _, client := range slices.Sorted(maps.Keys(roleMappings.ClientMappings)) {
+				clientRoleMapping := roleMappings.ClientMappings[client]
+
+				var roles []string
+				for _, role := range clientRoleMapping.Mappings {
+					roles = append(roles, role.Name)
+				}
+
+				clientRoles = append(clientRoles, map[string]interface{}{
+					"client_id": clientRoleMapping.Id,
+					"client":    clientRoleMapping.Client,
+					"roles":     roles,
+				})
+			}
+
+			userData["realm_roles"] = realmRoles
+			userData["client_roles"] = clientRoles
+		}
+
+		usersData = append(usersData, userData)
+	}
+
+	data.SetId(realmId)
+	data.Set("users", usersData)
+
+	return nil
+}
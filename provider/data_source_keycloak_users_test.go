@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakDataSourceUsers_attributeQuery(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+	department := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKeycloakUsers_attributeQuery(prefix, department, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_users.finance", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.keycloak_users.finance", "users.0.attributes.department", department),
+					resource.TestCheckResourceAttr("data.keycloak_users.finance", "users.0.realm_roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("data.keycloak_users.finance", "users.0.realm_roles.*", roleName),
+					resource.TestCheckResourceAttr("data.keycloak_users.disabled", "users.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_users.disabled", "users.0.username", prefix+"-other"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakDataSourceUsers_invalidFilters(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceKeycloakUsers_filters(`search = "john"`, `username = "john"`),
+				ExpectError: regexp.MustCompile(`"search": conflicts with username`),
+			},
+			{
+				Config:      testDataSourceKeycloakUsers_filters(`attributes = { department = "human resources" }`),
+				ExpectError: regexp.MustCompile(`attribute names and values must not contain spaces or colons`),
+			},
+		},
+	})
+}
+
+func testDataSourceKeycloakUsers_filters(filters ...string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+data "keycloak_users" "users" {
+	realm_id = data.keycloak_realm.realm.id
+
+	%s
+}
+	`, testAccRealm.Realm, strings.Join(filters, "\n\t"))
+}
+
+func testDataSourceKeycloakUsers_attributeQuery(prefix, department, roleName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_user" "finance" {
+	count    = 2
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-finance-${count.index}"
+
+	attributes = {
+		department = "%s"
+	}
+}
+
+resource "keycloak_user_roles" "finance" {
+	count    = 2
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.finance[count.index].id
+
+	role_ids   = [keycloak_role.role.id]
+	exhaustive = false
+}
+
+resource "keycloak_user" "other" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s-other"
+	enabled  = false
+
+	attributes = {
+		department = "%s-other"
+	}
+}
+
+data "keycloak_users" "finance" {
+	realm_id = data.keycloak_realm.realm.id
+	exact    = true
+
+	attributes = {
+		department = "%s"
+	}
+
+	include_roles = true
+
+	depends_on = [
+		keycloak_user_roles.finance,
+		keycloak_user.other,
+	]
+}
+
+data "keycloak_users" "disabled" {
+	realm_id = data.keycloak_realm.realm.id
+	search   = "%s"
+	enabled  = false
+
+	depends_on = [
+		keycloak_user.finance,
+		keycloak_user.other,
+	]
+}
+	`, testAccRealm.Realm, roleName, prefix, department, prefix, department, department, prefix)
+}
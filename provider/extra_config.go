@@ -3,6 +3,7 @@ package provider
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/go-cty/cty"
@@ -61,6 +62,12 @@ func setExtraConfigData(data *schema.ResourceData, extraConfig map[string]interf
 // validateExtraConfig takes a reflect.Value type to check its JSON schema in order to validate that extra_config
 // doesn't contain any attributes that could have been specified within the official schema
 func validateExtraConfig(reflectValue reflect.Value) schema.SchemaValidateDiagFunc {
+	return validateExtraConfigWithDeprecatedKeys(reflectValue, nil)
+}
+
+// validateExtraConfigWithDeprecatedKeys works like validateExtraConfig, but only warns about the given keys, which were
+// set through extra_config before they became top-level schema attributes. The keys map to the name of their attribute.
+func validateExtraConfigWithDeprecatedKeys(reflectValue reflect.Value, deprecatedKeys map[string]string) schema.SchemaValidateDiagFunc {
 	return func(v interface{}, path cty.Path) diag.Diagnostics {
 		var diags diag.Diagnostics
 
@@ -71,7 +78,20 @@ func validateExtraConfig(reflectValue reflect.Value) schema.SchemaValidateDiagFu
 			jsonKey := strings.Split(reflectValue.Type().Field(i).Tag.Get("json"), ",")[0]
 
 			if jsonKey != "-" && field.CanSet() {
-				if _, ok := extraConfig[jsonKey]; ok {
+				if _, ok := extraConfig[jsonKey]; !ok {
+					continue
+				}
+
+				if attribute, ok := deprecatedKeys[jsonKey]; ok {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  "Deprecated extra_config key",
+						Detail:   fmt.Sprintf(`extra_config key "%s" is deprecated and will be rejected in a future release, use the "%s" attribute instead`, jsonKey, attribute),
+						AttributePath: append(path, cty.IndexStep{
+							Key: cty.StringVal(jsonKey),
+						}),
+					})
+				} else {
 					diags = append(diags, diag.Diagnostic{
 						Severity: diag.Error,
 						Summary:  "Invalid extra_config key",
@@ -87,3 +107,59 @@ func validateExtraConfig(reflectValue reflect.Value) schema.SchemaValidateDiagFu
 		return diags
 	}
 }
+
+// applyDeprecatedExtraConfig moves the values of deprecated extra_config keys onto their struct fields, since the fields
+// take precedence over extra_config when the attributes are sent to Keycloak. A field that is set by its own attribute
+// keeps its value.
+func applyDeprecatedExtraConfig(reflectValue reflect.Value, extraConfig map[string]interface{}, deprecatedKeys map[string]string) {
+	for i := 0; i < reflectValue.NumField(); i++ {
+		field := reflectValue.Field(i)
+		jsonKey := strings.Split(reflectValue.Type().Field(i).Tag.Get("json"), ",")[0]
+
+		value, ok := extraConfig[jsonKey]
+		if _, deprecated := deprecatedKeys[jsonKey]; !ok || !deprecated || !field.CanSet() {
+			continue
+		}
+		delete(extraConfig, jsonKey)
+
+		if !field.IsZero() {
+			continue
+		}
+
+		switch field.Kind() {
+		case reflect.String:
+			field.SetString(value.(string))
+		case reflect.Bool:
+			if boolValue, err := strconv.ParseBool(value.(string)); err == nil {
+				field.SetBool(boolValue)
+			}
+		}
+	}
+}
+
+// setDeprecatedExtraConfigData is the counterpart of applyDeprecatedExtraConfig when reading the attributes back. As
+// long as the configuration sets a deprecated key through extra_config, its value is kept there and the field is left
+// at its zero value, so neither the key nor its attribute show a difference.
+func setDeprecatedExtraConfigData(data *schema.ResourceData, reflectValue reflect.Value, extraConfig map[string]interface{}, deprecatedKeys map[string]string) {
+	extraConfigFromState := getExtraConfigFromData(data)
+
+	for i := 0; i < reflectValue.NumField(); i++ {
+		field := reflectValue.Field(i)
+		jsonKey := strings.Split(reflectValue.Type().Field(i).Tag.Get("json"), ",")[0]
+
+		if _, deprecated := deprecatedKeys[jsonKey]; !deprecated || !field.CanSet() {
+			continue
+		}
+		if value, ok := extraConfigFromState[jsonKey]; !ok || value == "" {
+			continue
+		}
+
+		switch field.Kind() {
+		case reflect.String:
+			extraConfig[jsonKey] = field.String()
+		case reflect.Bool:
+			extraConfig[jsonKey] = strconv.FormatBool(field.Bool())
+		}
+		field.SetZero()
+	}
+}
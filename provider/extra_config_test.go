@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestValidateExtraConfigWithDeprecatedKeys(t *testing.T) {
+	validate := validateExtraConfigWithDeprecatedKeys(reflect.ValueOf(&keycloak.OpenidClientAttributes{}).Elem(), keycloakOpenidClientDeprecatedExtraConfigKeys)
+
+	diags := validate(map[string]interface{}{
+		"oidc.ciba.grant.enabled":    "true",
+		"pkce.code.challenge.method": "S256",
+		"custom":                     "value",
+	}, cty.GetAttrPath("extra_config"))
+
+	if len(diags) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %v", diags)
+	}
+
+	severities := map[diag.Severity]int{}
+	for _, d := range diags {
+		severities[d.Severity]++
+	}
+	if severities[diag.Warning] != 1 || severities[diag.Error] != 1 {
+		t.Errorf("expected a warning for the deprecated key and an error for the attribute key, got %v", diags)
+	}
+}
+
+func TestApplyDeprecatedExtraConfig(t *testing.T) {
+	attributes := keycloak.OpenidClientAttributes{
+		RequestObjectRequired: "request only",
+	}
+	extraConfig := map[string]interface{}{
+		"oidc.ciba.grant.enabled":              "true",
+		"ciba.backchannel.token.delivery.mode": "poll",
+		"request.object.required":              "not required",
+		"custom":                               "value",
+	}
+
+	applyDeprecatedExtraConfig(reflect.ValueOf(&attributes).Elem(), extraConfig, keycloakOpenidClientDeprecatedExtraConfigKeys)
+
+	if !attributes.CibaGrantEnabled || attributes.CibaBackchannelTokenDeliveryMode != "poll" {
+		t.Errorf("expected the deprecated keys to be applied, got %+v", attributes)
+	}
+	if attributes.RequestObjectRequired != "request only" {
+		t.Errorf("expected the attribute to take precedence, got %s", attributes.RequestObjectRequired)
+	}
+	if !reflect.DeepEqual(extraConfig, map[string]interface{}{"custom": "value"}) {
+		t.Errorf("expected only the custom key to be left in extra_config, got %v", extraConfig)
+	}
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// parseJavaProperties parses the content of a Java .properties file, like the message bundles of Keycloak themes.
+// It supports comments, line continuations, the '=', ':' and whitespace separators and the escape sequences of
+// java.util.Properties, including unicode escapes.
+func parseJavaProperties(content string) (map[string]string, error) {
+	properties := make(map[string]string)
+
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	content = strings.ReplaceAll(content, "\r", "\n")
+	lines := strings.Split(content, "\n")
+
+	for i := 0; i < len(lines); i++ {
+		lineNumber := i + 1
+		line := strings.TrimLeft(lines[i], " \t\f")
+
+		if line == "" || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+
+		// a line ending with an odd number of backslashes continues on the next line, without its leading whitespace
+		for endsWithContinuation(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[i], " \t\f")
+		}
+		if endsWithContinuation(line) {
+			line = line[:len(line)-1]
+		}
+
+		key, value := splitJavaPropertiesLine(line)
+
+		unescapedKey, err := unescapeJavaProperties(key)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+
+		unescapedValue, err := unescapeJavaProperties(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+
+		properties[unescapedKey] = unescapedValue
+	}
+
+	return properties, nil
+}
+
+func endsWithContinuation(line string) bool {
+	backslashes := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		backslashes++
+	}
+
+	return backslashes%2 == 1
+}
+
+// splitJavaPropertiesLine splits a logical line at the first unescaped separator, the key and value are still escaped
+func splitJavaPropertiesLine(line string) (string, string) {
+	keyEnd := len(line)
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+			continue
+		}
+		if strings.IndexByte("=: \t\f", line[i]) != -1 {
+			keyEnd = i
+			break
+		}
+	}
+
+	key := line[:keyEnd]
+	rest := strings.TrimLeft(line[keyEnd:], " \t\f")
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t\f")
+	}
+
+	return key, rest
+}
+
+func unescapeJavaProperties(s string) (string, error) {
+	if !strings.Contains(s, "\\") {
+		return s, nil
+	}
+
+	var builder strings.Builder
+	var pendingHighSurrogate rune
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			builder.WriteByte(s[i])
+			continue
+		}
+
+		i++
+		switch s[i] {
+		case 't':
+			builder.WriteByte('\t')
+		case 'n':
+			builder.WriteByte('\n')
+		case 'r':
+			builder.WriteByte('\r')
+		case 'f':
+			builder.WriteByte('\f')
+		case 'u':
+			if i+5 > len(s) {
+				return "", fmt.Errorf("malformed unicode escape \\%s", s[i:])
+			}
+
+			codePoint, err := strconv.ParseUint(s[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", fmt.Errorf("malformed unicode escape \\%s", s[i:i+5])
+			}
+			i += 4
+
+			// characters outside of the basic multilingual plane are escaped as UTF-16 surrogate pairs
+			r := rune(codePoint)
+			switch {
+			case r >= 0xD800 && r < 0xDC00:
+				pendingHighSurrogate = r
+				continue
+			case r >= 0xDC00 && r < 0xE000 && pendingHighSurrogate != 0:
+				r = (pendingHighSurrogate-0xD800)<<10 + (r - 0xDC00) + 0x10000
+			}
+			builder.WriteRune(r)
+		default:
+			builder.WriteByte(s[i])
+		}
+
+		pendingHighSurrogate = 0
+	}
+
+	return builder.String(), nil
+}
+
+// formatJavaProperties renders properties sorted by key, so that the same properties always produce the same content
+func formatJavaProperties(properties map[string]string) string {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var builder strings.Builder
+	for _, key := range keys {
+		builder.WriteString(escapeJavaProperties(key, true))
+		builder.WriteByte('=')
+		builder.WriteString(escapeJavaProperties(properties[key], false))
+		builder.WriteByte('\n')
+	}
+
+	return builder.String()
+}
+
+func escapeJavaProperties(s string, isKey bool) string {
+	var builder strings.Builder
+
+	for i, r := range s {
+		switch r {
+		case '\\':
+			builder.WriteString(`\\`)
+		case '\t':
+			builder.WriteString(`\t`)
+		case '\n':
+			builder.WriteString(`\n`)
+		case '\r':
+			builder.WriteString(`\r`)
+		case '\f':
+			builder.WriteString(`\f`)
+		case '=', ':', '#', '!':
+			if isKey {
+				builder.WriteByte('\\')
+			}
+			builder.WriteRune(r)
+		case ' ':
+			// spaces end a key, and leading spaces of a value would be trimmed
+			if isKey || i == 0 {
+				builder.WriteByte('\\')
+			}
+			builder.WriteRune(r)
+		default:
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseJavaProperties(t *testing.T) {
+	tests := map[string]struct {
+		content  string
+		expected map[string]string
+	}{
+		"separators": {
+			content:  "a=1\nb: 2\nc 3\nd\t=\t4\ne\n",
+			expected: map[string]string{"a": "1", "b": "2", "c": "3", "d": "4", "e": ""},
+		},
+		"comments and blank lines": {
+			content:  "# comment\n! comment\n\n   \nkey=value\n",
+			expected: map[string]string{"key": "value"},
+		},
+		"comments don't continue": {
+			content:  "# comment \\\nkey=value\n",
+			expected: map[string]string{"key": "value"},
+		},
+		"line continuations": {
+			content:  "greeting=Hello, \\\n    world\\\n\t!\nnext=1\n",
+			expected: map[string]string{"greeting": "Hello, world!", "next": "1"},
+		},
+		"escaped backslash doesn't continue": {
+			content:  "path=C:\\\\\nnext=1\n",
+			expected: map[string]string{"path": `C:\`, "next": "1"},
+		},
+		"continuation at the end of the content": {
+			content:  "key=value\\",
+			expected: map[string]string{"key": "value"},
+		},
+		"windows line endings": {
+			content:  "a=1\r\nb=2\\\r\n  3\r\n",
+			expected: map[string]string{"a": "1", "b": "23"},
+		},
+		"escapes": {
+			content:  "tab=a\\tb\nnewline=a\\nb\nother=\\q\nkey\\ with\\=separators\\:=value\n",
+			expected: map[string]string{"tab": "a\tb", "newline": "a\nb", "other": "q", "key with=separators:": "value"},
+		},
+		"unicode escapes": {
+			content:  "umlaut=\\u00fcber\nupper=\\u00DC\nemoji=\\ud83d\\ude00\nraw=über\n",
+			expected: map[string]string{"umlaut": "über", "upper": "Ü", "emoji": "😀", "raw": "über"},
+		},
+	}
+
+	for name, test := range tests {
+		properties, err := parseJavaProperties(test.content)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(properties, test.expected) {
+			t.Errorf("%s: expected %q, got %q", name, test.expected, properties)
+		}
+	}
+
+	for _, invalidContent := range []string{
+		"key=\\u12",
+		"key=\\u12zz",
+	} {
+		if _, err := parseJavaProperties(invalidContent); err == nil {
+			t.Errorf("expected an error for %q", invalidContent)
+		}
+	}
+}
+
+func TestFormatJavaProperties(t *testing.T) {
+	tests := map[string]struct {
+		properties map[string]string
+		expected   string
+	}{
+		"sorted by key": {
+			properties: map[string]string{"b": "2", "a": "1"},
+			expected:   "a=1\nb=2\n",
+		},
+		"separators in keys": {
+			properties: map[string]string{"a key=with:separators#!": "value=with:separators"},
+			expected:   "a\\ key\\=with\\:separators\\#\\!=value=with:separators\n",
+		},
+		"leading space of values": {
+			properties: map[string]string{"key": " two  spaces"},
+			expected:   "key=\\ two  spaces\n",
+		},
+		"control characters": {
+			properties: map[string]string{"key": "a\tb\nc\rd\fe\\f"},
+			expected:   "key=a\\tb\\nc\\rd\\fe\\\\f\n",
+		},
+		"unicode": {
+			properties: map[string]string{"key": "über 😀"},
+			expected:   "key=über 😀\n",
+		},
+	}
+
+	for name, test := range tests {
+		if content := formatJavaProperties(test.properties); content != test.expected {
+			t.Errorf("%s: expected %q, got %q", name, test.expected, content)
+		}
+	}
+}
+
+func TestJavaPropertiesRoundTrip(t *testing.T) {
+	properties := map[string]string{
+		"simple":                "value",
+		"empty":                 "",
+		"key with spaces":       "  leading and trailing  ",
+		"separators=:#!":        "=:#! values",
+		"multiline":             "first\nsecond\r\nthird",
+		"backslashes\\":         "C:\\path\\",
+		"tabs\tand\fform feeds": "\ttab",
+		"unicode":               "über 😀",
+		"#comment":              "!not a comment",
+	}
+
+	parsed, err := parseJavaProperties(formatJavaProperties(properties))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(parsed, properties) {
+		t.Errorf("expected %q, got %q", properties, parsed)
+	}
+}
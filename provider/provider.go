@@ -21,11 +21,22 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_openid_client_scope":                dataSourceKeycloakOpenidClientScope(),
 			"keycloak_openid_client_service_account_user": dataSourceKeycloakOpenidClientServiceAccountUser(),
 			"keycloak_realm":                              dataSourceKeycloakRealm(),
+			"keycloak_realm_client_policies":              dataSourceKeycloakRealmClientPolicies(),
 			"keycloak_realm_client_registration_policy":   dataSourceKeycloakRealmClientRegistrationPolicy(),
 			"keycloak_realm_keys":                         dataSourceKeycloakRealmKeys(),
+			"keycloak_events":                             dataSourceKeycloakEvents(),
+			"keycloak_admin_events":                       dataSourceKeycloakAdminEvents(),
 			"keycloak_role":                               dataSourceKeycloakRole(),
 			"keycloak_user":                               dataSourceKeycloakUser(),
 			"keycloak_user_realm_roles":                   dataSourceKeycloakUserRealmRoles(),
+			"keycloak_user_credentials":                   dataSourceKeycloakUserCredentials(),
+			"keycloak_user_consents":                      dataSourceKeycloakUserConsents(),
+			"keycloak_user_sessions":                      dataSourceKeycloakUserSessions(),
+			"keycloak_client_sessions":                    dataSourceKeycloakClientSessions(),
+			"keycloak_realm_client_session_stats":         dataSourceKeycloakRealmClientSessionStats(),
+			"keycloak_user_federated_identities":          dataSourceKeycloakUserFederatedIdentities(),
+			"keycloak_users":                              dataSourceKeycloakUsers(),
+			"keycloak_user_brute_force_status":            dataSourceKeycloakUserBruteForceStatus(),
 			"keycloak_saml_client_installation_provider":  dataSourceKeycloakSamlClientInstallationProvider(),
 			"keycloak_saml_client":                        dataSourceKeycloakSamlClient(),
 			"keycloak_saml_client_scope":                  dataSourceKeycloakSamlClientScope(),
@@ -44,14 +55,30 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_realm_client_policy_profile":                       resourceKeycloakRealmClientPolicyProfile(),
 			"keycloak_realm_client_policy_profile_policy":                resourceKeycloakRealmClientPolicyProfilePolicy(),
 			"keycloak_realm_client_registration_policy":                  resourceKeycloakRealmClientRegistrationPolicy(),
+			"keycloak_realm_client_initial_access":                       resourceKeycloakRealmClientInitialAccess(),
 			"keycloak_realm_keystore_aes_generated":                      resourceKeycloakRealmKeystoreAesGenerated(),
 			"keycloak_realm_keystore_ecdsa_generated":                    resourceKeycloakRealmKeystoreEcdsaGenerated(),
+			"keycloak_realm_keystore_eddsa_generated":                    resourceKeycloakRealmKeystoreEddsaGenerated(),
 			"keycloak_realm_keystore_hmac_generated":                     resourceKeycloakRealmKeystoreHmacGenerated(),
 			"keycloak_realm_keystore_java_keystore":                      resourceKeycloakRealmKeystoreJavaKeystore(),
 			"keycloak_realm_keystore_rsa":                                resourceKeycloakRealmKeystoreRsa(),
+			"keycloak_realm_keystore_rsa_enc_generated":                  resourceKeycloakRealmKeystoreRsaEncGenerated(),
 			"keycloak_realm_keystore_rsa_generated":                      resourceKeycloakRealmKeystoreRsaGenerated(),
+			"keycloak_realm_key_rotation":                                resourceKeycloakRealmKeyRotation(),
 			"keycloak_realm_user_profile":                                resourceKeycloakRealmUserProfile(),
+			"keycloak_realm_user_profile_attribute":                      resourceKeycloakRealmUserProfileAttribute(),
+			"keycloak_realm_user_profile_group":                          resourceKeycloakRealmUserProfileGroup(),
 			"keycloak_realm_localization":                                resourceKeycloakRealmLocalization(),
+			"keycloak_realm_localization_bundle":                         resourceKeycloakRealmLocalizationBundle(),
+			"keycloak_realm_brute_force_unlock":                          resourceKeycloakRealmBruteForceUnlock(),
+			"keycloak_realm_smtp_server":                                 resourceKeycloakRealmSmtpServer(),
+			"keycloak_realm_token_settings":                              resourceKeycloakRealmTokenSettings(),
+			"keycloak_realm_login_settings":                              resourceKeycloakRealmLoginSettings(),
+			"keycloak_realm_security_defenses":                           resourceKeycloakRealmSecurityDefenses(),
+			"keycloak_realm_otp_policy":                                  resourceKeycloakRealmOtpPolicy(),
+			"keycloak_realm_webauthn_policy":                             resourceKeycloakRealmWebAuthnPolicy(),
+			"keycloak_realm_themes":                                      resourceKeycloakRealmThemes(),
+			"keycloak_realm_not_before":                                  resourceKeycloakRealmNotBefore(),
 			"keycloak_required_action":                                   resourceKeycloakRequiredAction(),
 			"keycloak_group":                                             resourceKeycloakGroup(),
 			"keycloak_group_memberships":                                 resourceKeycloakGroupMemberships(),
@@ -60,6 +87,7 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_group_roles":                                       resourceKeycloakGroupRoles(),
 			"keycloak_user":                                              resourceKeycloakUser(),
 			"keycloak_user_roles":                                        resourceKeycloakUserRoles(),
+			"keycloak_user_logout":                                       resourceKeycloakUserLogout(),
 			"keycloak_openid_client":                                     resourceKeycloakOpenidClient(),
 			"keycloak_openid_client_scope":                               resourceKeycloakOpenidClientScope(),
 			"keycloak_ldap_user_federation":                              resourceKeycloakLdapUserFederation(),
@@ -89,6 +117,7 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_openid_user_session_note_protocol_mapper":          resourceKeycloakOpenIdUserSessionNoteProtocolMapper(),
 			"keycloak_openid_client_default_scopes":                      resourceKeycloakOpenidClientDefaultScopes(),
 			"keycloak_openid_client_optional_scopes":                     resourceKeycloakOpenidClientOptionalScopes(),
+			"keycloak_openid_client_not_before":                          resourceKeycloakOpenidClientNotBefore(),
 			"keycloak_organization":                                      resourceKeycloakOrganization(),
 			"keycloak_saml_client":                                       resourceKeycloakSamlClient(),
 			"keycloak_saml_client_scope":                                 resourceKeycloakSamlClientScope(),
@@ -139,6 +168,11 @@ func KeycloakProvider(client *keycloak.KeycloakClient) *schema.Provider {
 			"keycloak_openid_client_permissions":                         resourceKeycloakOpenidClientPermissions(),
 			"keycloak_users_permissions":                                 resourceKeycloakUsersPermissions(),
 			"keycloak_user_groups":                                       resourceKeycloakUserGroups(),
+			"keycloak_user_credential_policy":                            resourceKeycloakUserCredentialPolicy(),
+			"keycloak_user_consent_revocation":                           resourceKeycloakUserConsentRevocation(),
+			"keycloak_group_tree":                                        resourceKeycloakGroupTree(),
+			"keycloak_user_federated_identity":                           resourceKeycloakUserFederatedIdentity(),
+			"keycloak_users":                                             resourceKeycloakUsers(),
 			"keycloak_group_permissions":                                 resourceKeycloakGroupPermissions(),
 			"keycloak_role_admin_permissions":                            resourceKeycloakRoleAdminPermissions(),
 			"keycloak_group_admin_permissions":                           resourceKeycloakGroupAdminPermissions(),
@@ -173,9 +173,19 @@ func resourceKeycloakGroupDelete(ctx context.Context, data *schema.ResourceData,
 func resourceKeycloakGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
+	// group paths start with a slash, so {{realmId}}//{{path}} imports a group by its path
+	if realmId, path, ok := strings.Cut(d.Id(), "/"); ok && strings.HasPrefix(path, "/") {
+		group, err := keycloakClient.GetGroupByPath(ctx, realmId, path)
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(fmt.Sprintf("%s/%s", realmId, group.Id))
+	}
+
 	parts := strings.Split(d.Id(), "/")
 	if len(parts) != 2 && len(parts) != 3 {
-		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{groupId}} or {{realmId}}/{{organizationId}}/{{groupId}}")
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realmId}}/{{groupId}}, {{realmId}}/{{organizationId}}/{{groupId}} or {{realmId}}/{{groupPath}}")
 	}
 
 	realmId := parts[0]
@@ -308,6 +308,38 @@ func TestAccKeycloakGroup_nestedGroupNameContainsSpaces(t *testing.T) {
 	runTestNestedGroup(t, parentGroupName, firstChildGroupName, secondChildGroupName)
 }
 
+func TestAccKeycloakGroup_importByPath(t *testing.T) {
+	t.Parallel()
+
+	parentGroupName := acctest.RandomWithPrefix("tf-acc")
+	firstChildGroupName := acctest.RandomWithPrefix("tf-acc")
+	secondChildGroupName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroup_nested(parentGroupName, firstChildGroupName, secondChildGroupName, "keycloak_group.first_child_group"),
+				Check:  testAccCheckKeycloakGroupExists("keycloak_group.second_child_group"),
+			},
+			{
+				ResourceName:      "keycloak_group.parent_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGroupPathImportId("keycloak_group.parent_group"),
+			},
+			{
+				ResourceName:      "keycloak_group.second_child_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getGroupPathImportId("keycloak_group.second_child_group"),
+			},
+		},
+	})
+}
+
 func runTestNestedGroup(t *testing.T, parentGroupName, firstChildGroupName, secondChildGroupName string) {
 	parentGroupResource := "keycloak_group.parent_group"
 	firstChildGroupResource := "keycloak_group.first_child_group"
@@ -541,6 +573,17 @@ func getGroupWithOrganizationImportId(resourceName string) resource.ImportStateI
 	}
 }
 
+func getGroupPathImportId(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["path"]), nil
+	}
+}
+
 func testKeycloakGroup_basic(group string, attributeName string, attributeValue string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
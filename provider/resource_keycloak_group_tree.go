@@ -0,0 +1,964 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+const groupTreeDefaultParallelism = 4
+
+// groupTreeNode is a group of the groups_json document of keycloak_group_tree. Groups are identified by their key,
+// which defaults to their name, so that a group that changes parent is moved instead of being recreated.
+type groupTreeNode struct {
+	Key         string              `json:"key,omitempty"`
+	Name        string              `json:"name"`
+	Attributes  map[string][]string `json:"attributes,omitempty"`
+	RealmRoles  []string            `json:"realm_roles,omitempty"`
+	ClientRoles map[string][]string `json:"client_roles,omitempty"`
+	Children    []*groupTreeNode    `json:"children,omitempty"`
+}
+
+func (node *groupTreeNode) key() string {
+	if node.Key != "" {
+		return node.Key
+	}
+
+	return node.Name
+}
+
+func resourceKeycloakGroupTree() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakGroupTreeCreate,
+		ReadContext:   resourceKeycloakGroupTreeRead,
+		UpdateContext: resourceKeycloakGroupTreeUpdate,
+		DeleteContext: resourceKeycloakGroupTreeDelete,
+		CustomizeDiff: resourceKeycloakGroupTreeDiff,
+		// This resource can be imported using {{realm}} or {{realm}}/{{parent_id}}, every group below the parent becomes managed
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakGroupTreeImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parent_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"groups_json": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(i interface{}, k string) ([]string, []error) {
+					if _, err := parseGroupTreeJson(i.(string)); err != nil {
+						return nil, []error{fmt.Errorf("%s: %v", k, err)}
+					}
+
+					return nil, nil
+				},
+				DiffSuppressFunc: suppressEquivalentGroupTreeJson,
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      groupTreeDefaultParallelism,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+			"group_ids": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"paths": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func groupTreeId(realmId, parentId string) string {
+	if parentId == "" {
+		return realmId
+	}
+
+	return fmt.Sprintf("%s/%s", realmId, parentId)
+}
+
+// parseGroupTreeJson parses a groups_json document. Keys must be unique in the whole document, and names must be
+// unique among siblings like Keycloak requires.
+func parseGroupTreeJson(groupsJson string) ([]*groupTreeNode, error) {
+	var nodes []*groupTreeNode
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(groupsJson)))
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(&nodes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse groups: %v", err)
+	}
+
+	keys := map[string]bool{}
+
+	var validate func(nodes []*groupTreeNode, parentKey string) error
+	validate = func(nodes []*groupTreeNode, parentKey string) error {
+		names := map[string]bool{}
+
+		for _, node := range nodes {
+			if node == nil || node.Name == "" {
+				return fmt.Errorf("every group must have a name")
+			}
+
+			if names[node.Name] {
+				if parentKey == "" {
+					return fmt.Errorf("there is more than one top-level group named %s", node.Name)
+				}
+				return fmt.Errorf("group %s has more than one child named %s", parentKey, node.Name)
+			}
+			names[node.Name] = true
+
+			if keys[node.key()] {
+				return fmt.Errorf("more than one group has the key %s, set a unique key on groups that share a name", node.key())
+			}
+			keys[node.key()] = true
+
+			for clientId := range node.ClientRoles {
+				if clientId == "" {
+					return fmt.Errorf("the client roles of group %s must be keyed by client_id", node.key())
+				}
+			}
+
+			if err := validate(node.Children, node.key()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	err = validate(nodes, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+func sortedUniqueStrings(values []string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+
+	return slices.Compact(slices.Sorted(slices.Values(values)))
+}
+
+// normalizeGroupTree sorts the groups, roles and attribute values of a document, so that documents that only differ
+// in ordering are equal. Keys are only kept when they differ from the name.
+func normalizeGroupTree(nodes []*groupTreeNode) []*groupTreeNode {
+	normalized := make([]*groupTreeNode, 0, len(nodes))
+
+	for _, node := range nodes {
+		normalizedNode := &groupTreeNode{
+			Name:       node.Name,
+			RealmRoles: sortedUniqueStrings(node.RealmRoles),
+			Children:   normalizeGroupTree(node.Children),
+		}
+
+		if node.Key != node.Name {
+			normalizedNode.Key = node.Key
+		}
+
+		if len(node.Attributes) != 0 {
+			normalizedNode.Attributes = map[string][]string{}
+			for name, values := range node.Attributes {
+				normalizedNode.Attributes[name] = slices.Sorted(slices.Values(values))
+			}
+		}
+
+		for clientId, roles := range node.ClientRoles {
+			if len(roles) == 0 {
+				continue
+			}
+			if normalizedNode.ClientRoles == nil {
+				normalizedNode.ClientRoles = map[string][]string{}
+			}
+			normalizedNode.ClientRoles[clientId] = sortedUniqueStrings(roles)
+		}
+
+		if len(normalizedNode.Children) == 0 {
+			normalizedNode.Children = nil
+		}
+
+		normalized = append(normalized, normalizedNode)
+	}
+
+	slices.SortFunc(normalized, func(a, b *groupTreeNode) int {
+		return strings.Compare(a.key(), b.key())
+	})
+
+	return normalized
+}
+
+func groupTreeJson(nodes []*groupTreeNode) (string, error) {
+	groupsJson, err := json.Marshal(normalizeGroupTree(nodes))
+	if err != nil {
+		return "", err
+	}
+
+	return string(groupsJson), nil
+}
+
+func normalizeGroupTreeJson(groupsJson string) (string, error) {
+	nodes, err := parseGroupTreeJson(groupsJson)
+	if err != nil {
+		return "", err
+	}
+
+	return groupTreeJson(nodes)
+}
+
+func suppressEquivalentGroupTreeJson(_, old, new string, _ *schema.ResourceData) bool {
+	oldJson, err := normalizeGroupTreeJson(old)
+	if err != nil {
+		return false
+	}
+
+	newJson, err := normalizeGroupTreeJson(new)
+	if err != nil {
+		return false
+	}
+
+	return oldJson == newJson
+}
+
+func resourceKeycloakGroupTreeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	if diff.NewValueKnown("groups_json") {
+		oldGroupsJson, newGroupsJson := diff.GetChange("groups_json")
+
+		oldJson, _ := normalizeGroupTreeJson(oldGroupsJson.(string))
+		newJson, err := normalizeGroupTreeJson(newGroupsJson.(string))
+		if err != nil {
+			return err
+		}
+
+		if oldJson == newJson {
+			return nil
+		}
+	}
+
+	// groups that are added or removed change the ids and paths
+	if err := diff.SetNewComputed("group_ids"); err != nil {
+		return err
+	}
+
+	return diff.SetNewComputed("paths")
+}
+
+// groupTreeEntry is a group of the document along with the key of its parent, which is empty for top-level groups
+type groupTreeEntry struct {
+	node      *groupTreeNode
+	parentKey string
+}
+
+// groupTreeLevels returns the groups of the document by depth, so that every group comes after its parent
+func groupTreeLevels(nodes []*groupTreeNode) [][]groupTreeEntry {
+	var levels [][]groupTreeEntry
+
+	level := make([]groupTreeEntry, 0, len(nodes))
+	for _, node := range nodes {
+		level = append(level, groupTreeEntry{node: node})
+	}
+
+	for len(level) != 0 {
+		levels = append(levels, level)
+
+		var next []groupTreeEntry
+		for _, entry := range level {
+			for _, child := range entry.node.Children {
+				next = append(next, groupTreeEntry{node: child, parentKey: entry.node.key()})
+			}
+		}
+		level = next
+	}
+
+	return levels
+}
+
+// groupTreeState is the part of the group hierarchy of a realm that is relevant to a keycloak_group_tree
+type groupTreeState struct {
+	// every group that was listed, by id
+	groups map[string]*keycloak.Group
+	// the children of every group that was descended into, by parent id
+	children map[string][]*keycloak.Group
+}
+
+// getGroupTreeState lists the groups below parentId level by level, only descending into the groups for which
+// descend returns true
+func getGroupTreeState(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, parentId string, parallelism int, descend func(group *keycloak.Group) bool) (*groupTreeState, error) {
+	state := &groupTreeState{
+		groups:   map[string]*keycloak.Group{},
+		children: map[string][]*keycloak.Group{},
+	}
+
+	var mutex sync.Mutex
+	level := []string{parentId}
+
+	for len(level) != 0 {
+		var next []string
+
+		errs := runInParallel(len(level), parallelism, func(i int) error {
+			children, err := keycloakClient.GetGroupChildren(ctx, realmId, level[i])
+			if err != nil {
+				return err
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			state.children[level[i]] = children
+			for _, child := range children {
+				state.groups[child.Id] = child
+
+				if descend(child) && (child.SubGroupCount == nil || *child.SubGroupCount != 0) {
+					next = append(next, child.Id)
+				}
+			}
+
+			return nil
+		})
+		for _, err := range errs {
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		level = next
+	}
+
+	return state, nil
+}
+
+// buildGroupTree returns the document of the managed groups below parentId, as they are in Keycloak
+func buildGroupTree(state *groupTreeState, parentId string, keys map[string]string) []*groupTreeNode {
+	var nodes []*groupTreeNode
+
+	for _, group := range state.children[parentId] {
+		key, ok := keys[group.Id]
+		if !ok {
+			continue
+		}
+
+		nodes = append(nodes, &groupTreeNode{
+			Key:         key,
+			Name:        group.Name,
+			Attributes:  group.Attributes,
+			RealmRoles:  group.RealmRoles,
+			ClientRoles: group.ClientRoles,
+			Children:    buildGroupTree(state, group.Id, keys),
+		})
+	}
+
+	return nodes
+}
+
+// groupTreeReconciler creates, moves, updates and deletes groups so that the subtree matches the document
+type groupTreeReconciler struct {
+	ctx            context.Context
+	keycloakClient *keycloak.KeycloakClient
+	realmId        string
+	parentId       string
+	parallelism    int
+
+	state      *groupTreeState
+	managedIds map[string]string
+	// the ids of managedIds, to tell managed groups apart from groups that were created outside of this resource
+	managedIdSet map[string]bool
+
+	mutex     sync.Mutex
+	groupIds  map[string]string
+	clientIds map[string]string
+	roles     map[string]*keycloak.Role
+}
+
+func newGroupTreeReconciler(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, parentId string, parallelism int, managedIds map[string]string, nodes []*groupTreeNode) (*groupTreeReconciler, error) {
+	reconciler := &groupTreeReconciler{
+		ctx:            ctx,
+		keycloakClient: keycloakClient,
+		realmId:        realmId,
+		parentId:       parentId,
+		parallelism:    parallelism,
+		managedIds:     managedIds,
+		managedIdSet:   map[string]bool{},
+		groupIds:       map[string]string{},
+		clientIds:      map[string]string{},
+		roles:          map[string]*keycloak.Role{},
+	}
+
+	for _, id := range managedIds {
+		reconciler.managedIdSet[id] = true
+	}
+
+	// the children of unmanaged groups are never touched, so they aren't listed
+	state, err := getGroupTreeState(ctx, keycloakClient, realmId, parentId, parallelism, func(group *keycloak.Group) bool {
+		return reconciler.managedIdSet[group.Id]
+	})
+	if err != nil {
+		return nil, err
+	}
+	reconciler.state = state
+
+	// managed groups that were moved out of the subtree are moved back
+	for _, id := range managedIds {
+		if _, ok := state.groups[id]; ok {
+			continue
+		}
+
+		group, err := keycloakClient.GetGroup(ctx, realmId, id)
+		if err != nil {
+			if keycloak.ErrorIs404(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		state.groups[id] = group
+	}
+
+	return reconciler, nil
+}
+
+// existingGroup returns the group that is managed with the given key. Groups that were created outside of this resource
+// are never taken over, as destroying the resource would delete them, so an error is returned when one of them has the
+// name of the node.
+func (reconciler *groupTreeReconciler) existingGroup(node *groupTreeNode, parentId string) (*keycloak.Group, error) {
+	if id, ok := reconciler.managedIds[node.key()]; ok {
+		if group, ok := reconciler.state.groups[id]; ok {
+			return group, nil
+		}
+	}
+
+	for _, group := range reconciler.state.children[parentId] {
+		if group.Name == node.Name && !reconciler.managedIdSet[group.Id] {
+			return nil, fmt.Errorf("group %s already exists, import the groups below the parent to manage it with terraform", group.Path)
+		}
+	}
+
+	return nil, nil
+}
+
+func (reconciler *groupTreeReconciler) getRole(clientId, roleName string) (*keycloak.Role, error) {
+	roleKey := clientId + "/" + roleName
+
+	reconciler.mutex.Lock()
+	role, ok := reconciler.roles[roleKey]
+	clientUuid, clientOk := reconciler.clientIds[clientId]
+	reconciler.mutex.Unlock()
+
+	if ok {
+		return role, nil
+	}
+
+	if clientId != "" && !clientOk {
+		client, err := reconciler.keycloakClient.GetGenericClientByClientId(reconciler.ctx, reconciler.realmId, clientId)
+		if err != nil {
+			return nil, err
+		}
+		clientUuid = client.Id
+	}
+
+	role, err := reconciler.keycloakClient.GetRoleByName(reconciler.ctx, reconciler.realmId, clientUuid, roleName)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			if clientId == "" {
+				return nil, fmt.Errorf("realm role %s does not exist", roleName)
+			}
+			return nil, fmt.Errorf("role %s of client %s does not exist", roleName, clientId)
+		}
+		return nil, err
+	}
+
+	reconciler.mutex.Lock()
+	reconciler.roles[roleKey] = role
+	if clientId != "" {
+		reconciler.clientIds[clientId] = clientUuid
+	}
+	reconciler.mutex.Unlock()
+
+	return role, nil
+}
+
+func (reconciler *groupTreeReconciler) getRoles(clientId string, roleNames []string) ([]*keycloak.Role, error) {
+	var roles []*keycloak.Role
+
+	for _, roleName := range roleNames {
+		role, err := reconciler.getRole(clientId, roleName)
+		if err != nil {
+			return nil, err
+		}
+
+		roles = append(roles, role)
+	}
+
+	return roles, nil
+}
+
+// reconcileGroupRoles adds and removes the role mappings of a group, current is nil for groups that were just created
+func (reconciler *groupTreeReconciler) reconcileGroupRoles(groupId string, current *keycloak.Group, node *groupTreeNode) error {
+	var currentRealmRoles []string
+	currentClientRoles := map[string][]string{}
+	if current != nil {
+		currentRealmRoles = current.RealmRoles
+		maps.Copy(currentClientRoles, current.ClientRoles)
+	}
+
+	realmRolesToAdd, err := reconciler.getRoles("", stringArrayDifference(node.RealmRoles, currentRealmRoles))
+	if err != nil {
+		return err
+	}
+	realmRolesToRemove, err := reconciler.getRoles("", stringArrayDifference(currentRealmRoles, node.RealmRoles))
+	if err != nil {
+		return err
+	}
+
+	if len(realmRolesToAdd) != 0 {
+		err = reconciler.keycloakClient.AddRealmRolesToGroup(reconciler.ctx, reconciler.realmId, groupId, realmRolesToAdd)
+		if err != nil {
+			return err
+		}
+	}
+	if len(realmRolesToRemove) != 0 {
+		err = reconciler.keycloakClient.RemoveRealmRolesFromGroup(reconciler.ctx, reconciler.realmId, groupId, realmRolesToRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	clientIds := slices.Sorted(maps.Keys(node.ClientRoles))
+	for clientId := range currentClientRoles {
+		if !slices.Contains(clientIds, clientId) {
+			clientIds = append(clientIds, clientId)
+		}
+	}
+
+	for _, clientId := range clientIds {
+		rolesToAdd, err := reconciler.getRoles(clientId, stringArrayDifference(node.ClientRoles[clientId], currentClientRoles[clientId]))
+		if err != nil {
+			return err
+		}
+		rolesToRemove, err := reconciler.getRoles(clientId, stringArrayDifference(currentClientRoles[clientId], node.ClientRoles[clientId]))
+		if err != nil {
+			return err
+		}
+
+		if len(rolesToAdd) != 0 {
+			err = reconciler.keycloakClient.AddClientRolesToGroup(reconciler.ctx, reconciler.realmId, groupId, rolesToAdd[0].ClientId, rolesToAdd)
+			if err != nil {
+				return err
+			}
+		}
+		if len(rolesToRemove) != 0 {
+			err = reconciler.keycloakClient.RemoveClientRolesFromGroup(reconciler.ctx, reconciler.realmId, groupId, rolesToRemove[0].ClientId, rolesToRemove)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// reconcileGroup creates, moves or updates the group of a node and returns its id, even when its roles could
+// not be reconciled
+func (reconciler *groupTreeReconciler) reconcileGroup(node *groupTreeNode, parentId string) (string, error) {
+	attributes := node.Attributes
+	if attributes == nil {
+		attributes = map[string][]string{}
+	}
+
+	group := &keycloak.Group{
+		RealmId:    reconciler.realmId,
+		ParentId:   parentId,
+		Name:       node.Name,
+		Attributes: attributes,
+	}
+
+	current, err := reconciler.existingGroup(node, parentId)
+	if err != nil {
+		return "", err
+	}
+
+	if current == nil {
+		err := reconciler.keycloakClient.NewGroup(reconciler.ctx, group)
+		if err != nil {
+			return "", err
+		}
+
+		return group.Id, reconciler.reconcileGroupRoles(group.Id, nil, node)
+	}
+
+	group.Id = current.Id
+	group.Description = current.Description
+
+	if current.ParentId != parentId {
+		// moving the group keeps its members, and updates its name and attributes at the same time
+		err = reconciler.keycloakClient.MoveGroup(reconciler.ctx, group)
+	} else if current.Name != group.Name || !multivalueAttributesEqual(current.Attributes, group.Attributes) {
+		err = reconciler.keycloakClient.UpdateGroup(reconciler.ctx, group)
+	}
+	if err != nil {
+		return group.Id, err
+	}
+
+	return group.Id, reconciler.reconcileGroupRoles(group.Id, current, node)
+}
+
+// reconcile applies the document level by level and returns the ids of the managed groups by key. Failures of
+// individual groups are returned as diagnostics with the given severity.
+func (reconciler *groupTreeReconciler) reconcile(nodes []*groupTreeNode, severity diag.Severity) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	groupError := func(key string, err error) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("error managing group %s", key),
+			Detail:   err.Error(),
+		})
+	}
+
+	levels := groupTreeLevels(nodes)
+
+	desiredKeys := map[string]bool{}
+	keptIds := map[string]bool{}
+	for _, level := range levels {
+		for _, entry := range level {
+			desiredKeys[entry.node.key()] = true
+
+			if id, ok := reconciler.managedIds[entry.node.key()]; ok {
+				keptIds[id] = true
+			}
+		}
+	}
+
+	// Groups that were removed from the document are deleted before any group is moved, so that a group can take the
+	// name of a removed sibling. Groups that still contain groups of the document are deleted once those are moved out.
+	var removedKeys, removedParentKeys []string
+	for _, key := range slices.Sorted(maps.Keys(reconciler.managedIds)) {
+		if desiredKeys[key] {
+			continue
+		}
+
+		if reconciler.containsAny(reconciler.managedIds[key], keptIds) {
+			removedParentKeys = append(removedParentKeys, key)
+		} else {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+
+	deleteRemovedGroups := func(keys []string) {
+		for i, err := range reconciler.deleteGroups(keys) {
+			if err != nil {
+				// the group is still managed, so deleting it is retried
+				reconciler.groupIds[keys[i]] = reconciler.managedIds[keys[i]]
+				groupError(keys[i], err)
+			}
+		}
+	}
+
+	deleteRemovedGroups(removedKeys)
+
+	for _, level := range levels {
+		errs := runInParallel(len(level), reconciler.parallelism, func(i int) error {
+			entry := level[i]
+
+			parentId := reconciler.parentId
+			if entry.parentKey != "" {
+				reconciler.mutex.Lock()
+				id, ok := reconciler.groupIds[entry.parentKey]
+				reconciler.mutex.Unlock()
+
+				if !ok {
+					return fmt.Errorf("parent group %s could not be created", entry.parentKey)
+				}
+				parentId = id
+			}
+
+			id, err := reconciler.reconcileGroup(entry.node, parentId)
+			if id != "" {
+				reconciler.mutex.Lock()
+				reconciler.groupIds[entry.node.key()] = id
+				reconciler.mutex.Unlock()
+			}
+
+			return err
+		})
+
+		for i, err := range errs {
+			if err != nil {
+				groupError(level[i].node.key(), err)
+			}
+		}
+	}
+
+	deleteRemovedGroups(removedParentKeys)
+
+	return reconciler.groupIds, diags
+}
+
+// containsAny reports whether any of the given ids is below the group in Keycloak
+func (reconciler *groupTreeReconciler) containsAny(groupId string, ids map[string]bool) bool {
+	for _, child := range reconciler.state.children[groupId] {
+		if ids[child.Id] || reconciler.containsAny(child.Id, ids) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// deleteGroups deletes the managed groups with the given keys and returns the error of each of them. Managed groups
+// may have been moved anywhere, so each one is deleted by its own request, deepest first so that no group is deleted
+// along with its parent while its own request is pending. Groups that no longer exist are ignored.
+func (reconciler *groupTreeReconciler) deleteGroups(keys []string) []error {
+	errs := make([]error, len(keys))
+
+	keysByDepth := map[int][]int{}
+	for i, key := range keys {
+		depth := 0
+		if group, ok := reconciler.state.groups[reconciler.managedIds[key]]; ok {
+			depth = strings.Count(group.Path, "/")
+		}
+		keysByDepth[depth] = append(keysByDepth[depth], i)
+	}
+
+	for _, depth := range slices.Backward(slices.Sorted(maps.Keys(keysByDepth))) {
+		level := keysByDepth[depth]
+
+		levelErrs := runInParallel(len(level), reconciler.parallelism, func(i int) error {
+			err := reconciler.keycloakClient.DeleteGroup(reconciler.ctx, reconciler.realmId, reconciler.managedIds[keys[level[i]]])
+			if err != nil && !keycloak.ErrorIs404(err) {
+				return err
+			}
+
+			return nil
+		})
+		for i, err := range levelErrs {
+			errs[level[i]] = err
+		}
+	}
+
+	return errs
+}
+
+func getGroupTreeManagedIds(groupIds interface{}) map[string]string {
+	managedIds := map[string]string{}
+	for key, id := range groupIds.(map[string]interface{}) {
+		managedIds[key] = id.(string)
+	}
+
+	return managedIds
+}
+
+// resourceKeycloakGroupTreeApply reconciles the subtree with the document and stores the ids of the managed groups
+func resourceKeycloakGroupTreeApply(ctx context.Context, data *schema.ResourceData, keycloakClient *keycloak.KeycloakClient, managedIds map[string]string, severity diag.Severity) (diag.Diagnostics, error) {
+	realmId := data.Get("realm_id").(string)
+	parentId := data.Get("parent_id").(string)
+
+	nodes, err := parseGroupTreeJson(data.Get("groups_json").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	reconciler, err := newGroupTreeReconciler(ctx, keycloakClient, realmId, parentId, data.Get("parallelism").(int), managedIds, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	// unmanaged groups below the parent fail the whole apply, before any group is changed
+	for _, node := range nodes {
+		if _, err := reconciler.existingGroup(node, parentId); err != nil {
+			return nil, err
+		}
+	}
+
+	groupIds, diags := reconciler.reconcile(nodes, severity)
+
+	data.SetId(groupTreeId(realmId, parentId))
+	data.Set("group_ids", groupIds)
+
+	return diags, nil
+}
+
+func resourceKeycloakGroupTreeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// groups that failed are reported as warnings, so the groups that were created aren't replaced. The next plan
+	// shows the groups that are still missing.
+	diags, err := resourceKeycloakGroupTreeApply(ctx, data, keycloakClient, map[string]string{}, diag.Warning)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(diags, resourceKeycloakGroupTreeRead(ctx, data, meta)...)
+}
+
+func resourceKeycloakGroupTreeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	parentId := data.Get("parent_id").(string)
+	managedIds := getGroupTreeManagedIds(data.Get("group_ids"))
+
+	keys := map[string]string{}
+	for key, id := range managedIds {
+		keys[id] = key
+	}
+
+	parallelism := data.Get("parallelism").(int)
+	if parallelism == 0 {
+		parallelism = groupTreeDefaultParallelism
+	}
+
+	state, err := getGroupTreeState(ctx, keycloakClient, realmId, parentId, parallelism, func(group *keycloak.Group) bool {
+		_, ok := keys[group.Id]
+		return ok
+	})
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// managed groups that were deleted or moved out of the subtree are left out, so they show up in the plan
+	groupsJson, err := groupTreeJson(buildGroupTree(state, parentId, keys))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	paths := map[string]string{}
+	for key, id := range managedIds {
+		if group, ok := state.groups[id]; ok {
+			paths[key] = group.Path
+		}
+	}
+
+	data.Set("groups_json", groupsJson)
+	data.Set("paths", paths)
+
+	return nil
+}
+
+func resourceKeycloakGroupTreeUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	previousGroupIds, _ := data.GetChange("group_ids")
+
+	diags, err := resourceKeycloakGroupTreeApply(ctx, data, keycloakClient, getGroupTreeManagedIds(previousGroupIds), diag.Error)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(diags, resourceKeycloakGroupTreeRead(ctx, data, meta)...)
+}
+
+func resourceKeycloakGroupTreeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	parentId := data.Get("parent_id").(string)
+	managedIds := getGroupTreeManagedIds(data.Get("group_ids"))
+
+	parallelism := data.Get("parallelism").(int)
+	if parallelism == 0 {
+		parallelism = groupTreeDefaultParallelism
+	}
+
+	reconciler, err := newGroupTreeReconciler(ctx, keycloakClient, realmId, parentId, parallelism, managedIds, nil)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// every managed group is deleted, not only the top-level groups of the document, as some may have been moved
+	errs := reconciler.deleteGroups(slices.Sorted(maps.Keys(managedIds)))
+	for _, err := range errs {
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakGroupTreeImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) > 2 || parts[0] == "" {
+		return nil, fmt.Errorf("Invalid import. Supported import formats: {{realm}}, {{realm}}/{{parent_id}}")
+	}
+
+	realmId := parts[0]
+	parentId := ""
+	parentPath := ""
+	if len(parts) == 2 {
+		parentId = parts[1]
+
+		parent, err := keycloakClient.GetGroup(ctx, realmId, parentId)
+		if err != nil {
+			return nil, err
+		}
+		parentPath = parent.Path
+	}
+
+	state, err := getGroupTreeState(ctx, keycloakClient, realmId, parentId, groupTreeDefaultParallelism, func(*keycloak.Group) bool {
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]int{}
+	for _, group := range state.groups {
+		names[group.Name]++
+	}
+
+	// groups are keyed by name, or by their path below the parent when their name isn't unique
+	groupIds := map[string]string{}
+	for _, group := range state.groups {
+		key := group.Name
+		if names[group.Name] > 1 {
+			key = strings.TrimPrefix(group.Path, parentPath+"/")
+		}
+
+		groupIds[key] = group.Id
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("parent_id", parentId)
+	d.Set("parallelism", groupTreeDefaultParallelism)
+	d.Set("group_ids", groupIds)
+	d.SetId(groupTreeId(realmId, parentId))
+
+	diags := resourceKeycloakGroupTreeRead(ctx, d, meta)
+	if diags.HasError() {
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
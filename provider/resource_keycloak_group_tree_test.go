@@ -0,0 +1,387 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestParseGroupTreeJson(t *testing.T) {
+	nodes, err := parseGroupTreeJson(`[
+		{"name": "Engineering", "children": [{"name": "Platform"}, {"key": "eng-sales", "name": "Sales"}]},
+		{"name": "Sales", "realm_roles": ["seller"], "client_roles": {"crm": ["user"]}}
+	]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(nodes) != 2 || len(nodes[0].Children) != 2 || nodes[0].Children[1].key() != "eng-sales" || nodes[1].key() != "Sales" {
+		t.Fatalf("unexpected groups %+v", nodes)
+	}
+
+	for _, invalidJson := range []string{
+		`{"name": "Engineering"}`,
+		`[{"name": ""}]`,
+		`[{"name": "Engineering", "unknown": true}]`,
+		`[{"name": "Engineering"}, {"name": "Engineering"}]`,
+		`[{"name": "Engineering", "children": [{"name": "Platform"}, {"name": "Platform", "key": "other"}]}]`,
+		`[{"name": "Engineering", "children": [{"name": "Sales"}]}, {"name": "Sales"}]`,
+		`[{"name": "Engineering", "client_roles": {"": ["user"]}}]`,
+	} {
+		if _, err := parseGroupTreeJson(invalidJson); err == nil {
+			t.Errorf("expected an error for %s", invalidJson)
+		}
+	}
+}
+
+func TestNormalizeGroupTreeJson(t *testing.T) {
+	a, err := normalizeGroupTreeJson(`[
+		{"name": "Sales", "key": "Sales", "attributes": {"region": ["us", "eu"]}, "realm_roles": ["b", "a"], "children": []},
+		{"name": "Engineering", "client_roles": {"crm": [], "erp": ["user"]}}
+	]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := normalizeGroupTreeJson(`[
+		{"name": "Engineering", "client_roles": {"erp": ["user"]}},
+		{"name": "Sales", "attributes": {"region": ["eu", "us"]}, "realm_roles": ["a", "b"]}
+	]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a != b {
+		t.Fatalf("expected equivalent documents to normalize to the same json, got %s and %s", a, b)
+	}
+
+	expected := `[{"name":"Engineering","client_roles":{"erp":["user"]}},{"name":"Sales","attributes":{"region":["eu","us"]},"realm_roles":["a","b"]}]`
+	if a != expected {
+		t.Fatalf("expected %s, got %s", expected, a)
+	}
+}
+
+func TestGroupTreeLevels(t *testing.T) {
+	nodes, err := parseGroupTreeJson(`[{"name": "a", "children": [{"name": "b", "children": [{"name": "c"}]}]}, {"name": "d"}]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var levels []string
+	for _, level := range groupTreeLevels(nodes) {
+		var entries []string
+		for _, entry := range level {
+			entries = append(entries, entry.parentKey+">"+entry.node.key())
+		}
+		levels = append(levels, strings.Join(entries, ","))
+	}
+
+	expected := ">a,>d|a>b|b>c"
+	if strings.Join(levels, "|") != expected {
+		t.Fatalf("expected levels %s, got %s", expected, strings.Join(levels, "|"))
+	}
+}
+
+func TestBuildGroupTree(t *testing.T) {
+	state := &groupTreeState{
+		children: map[string][]*keycloak.Group{
+			"": {
+				{Id: "1", Name: "Engineering", RealmRoles: []string{"developer"}},
+				{Id: "2", Name: "Unmanaged"},
+			},
+			"1": {
+				{Id: "3", Name: "Platform", Attributes: map[string][]string{"cost_center": {"100"}}},
+			},
+		},
+	}
+
+	groupsJson, err := groupTreeJson(buildGroupTree(state, "", map[string]string{"1": "Engineering", "3": "platform-team"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `[{"name":"Engineering","realm_roles":["developer"],"children":[{"key":"platform-team","name":"Platform","attributes":{"cost_center":["100"]}}]}]`
+	if groupsJson != expected {
+		t.Fatalf("expected %s, got %s", expected, groupsJson)
+	}
+}
+
+func TestAccKeycloakGroupTree_basic(t *testing.T) {
+	t.Parallel()
+	rootName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	var platformId string
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakGroupTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupTree_basic(rootName, roleName, "Engineering"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_group_tree.tree", "group_ids.%", "4"),
+					resource.TestCheckResourceAttr("keycloak_group_tree.tree", "paths.Platform", fmt.Sprintf("/%s/Engineering/Platform", rootName)),
+					testAccCheckKeycloakGroupTreeGroup("Engineering", func(group *keycloak.Group) error {
+						if len(group.RealmRoles) != 1 || group.RealmRoles[0] != roleName {
+							return fmt.Errorf("expected group Engineering to have realm role %s, got %v", roleName, group.RealmRoles)
+						}
+						if len(group.Attributes["cost_center"]) != 1 || group.Attributes["cost_center"][0] != "100" {
+							return fmt.Errorf("expected group Engineering to have attribute cost_center, got %v", group.Attributes)
+						}
+						return nil
+					}),
+					testAccCheckKeycloakGroupTreeGroup("Platform", func(group *keycloak.Group) error {
+						platformId = group.Id
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testKeycloakGroupTree_basic(rootName, roleName, "Sales"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_group_tree.tree", "paths.Platform", fmt.Sprintf("/%s/Sales/Platform", rootName)),
+					testAccCheckKeycloakGroupTreeGroup("Platform", func(group *keycloak.Group) error {
+						if group.Id != platformId {
+							return fmt.Errorf("expected group Platform to be moved, but it was recreated")
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      "keycloak_group_tree.tree",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources["keycloak_group_tree.tree"]
+					return fmt.Sprintf("%s/%s", testAccRealm.Realm, rs.Primary.Attributes["parent_id"]), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGroupTree_duplicateKeys(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group_tree" "tree" {
+	realm_id    = data.keycloak_realm.realm.id
+	groups_json = jsonencode([
+		{ name = "Engineering", children = [{ name = "Support" }] },
+		{ name = "Sales", children = [{ name = "Support" }] },
+	])
+}
+				`, testAccRealm.Realm),
+				ExpectError: regexp.MustCompile("more than one group has the key Support"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGroupTree_existingGroup(t *testing.T) {
+	t.Parallel()
+	rootName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "root" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_group" "existing" {
+	realm_id  = data.keycloak_realm.realm.id
+	parent_id = keycloak_group.root.id
+	name      = "Engineering"
+}
+
+resource "keycloak_group_tree" "tree" {
+	realm_id    = data.keycloak_realm.realm.id
+	parent_id   = keycloak_group.root.id
+	groups_json = jsonencode([{ name = "Engineering" }])
+
+	depends_on = [keycloak_group.existing]
+}
+				`, testAccRealm.Realm, rootName),
+				ExpectError: regexp.MustCompile("group /.+/Engineering already exists, import the groups below the parent"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakGroupTree_replaceRemovedSibling(t *testing.T) {
+	t.Parallel()
+	engineeringName := acctest.RandomWithPrefix("tf-acc")
+	salesName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakGroupTreeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakGroupTree_siblings(engineeringName, salesName, `[{ key = "old-support", name = "Support" }]`, `[{ key = "new-support", name = "Support" }]`),
+				Check: testAccCheckKeycloakGroupTreeGroup("old-support", func(group *keycloak.Group) error {
+					if group.Path != "/"+engineeringName+"/Support" {
+						return fmt.Errorf("expected group old-support to have path /%s/Support, got %s", engineeringName, group.Path)
+					}
+
+					return nil
+				}),
+			},
+			{
+				// the removed group is deleted before the other one is moved next to it
+				Config: testKeycloakGroupTree_siblings(engineeringName, salesName, `[{ key = "new-support", name = "Support" }]`, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("keycloak_group_tree.tree", "group_ids.old-support"),
+					testAccCheckKeycloakGroupTreeGroup("new-support", func(group *keycloak.Group) error {
+						if group.Path != "/"+engineeringName+"/Support" {
+							return fmt.Errorf("expected group new-support to have path /%s/Support, got %s", engineeringName, group.Path)
+						}
+
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakGroupTreeGroup(key string, check func(group *keycloak.Group) error) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["keycloak_group_tree.tree"]
+		if !ok {
+			return fmt.Errorf("resource not found: keycloak_group_tree.tree")
+		}
+
+		id := rs.Primary.Attributes["group_ids."+key]
+		if id == "" {
+			return fmt.Errorf("group %s is not managed by keycloak_group_tree.tree", key)
+		}
+
+		group, err := keycloakClient.GetGroup(testCtx, rs.Primary.Attributes["realm_id"], id)
+		if err != nil {
+			return err
+		}
+
+		return check(group)
+	}
+}
+
+func testAccCheckKeycloakGroupTreeDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_group_tree" {
+				continue
+			}
+
+			for key, value := range rs.Primary.Attributes {
+				if !strings.HasPrefix(key, "group_ids.") || key == "group_ids.%" {
+					continue
+				}
+
+				group, _ := keycloakClient.GetGroup(testCtx, rs.Primary.Attributes["realm_id"], value)
+				if group != nil {
+					return fmt.Errorf("group %s still exists", value)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakGroupTree_basic(rootName, roleName, platformParent string) string {
+	engineeringChildren := `[{ name = "Platform" }]`
+	salesChildren := `[]`
+	if platformParent == "Sales" {
+		engineeringChildren, salesChildren = salesChildren, engineeringChildren
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_group" "root" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_group_tree" "tree" {
+	realm_id  = data.keycloak_realm.realm.id
+	parent_id = keycloak_group.root.id
+
+	groups_json = jsonencode([
+		{
+			name        = "Engineering"
+			attributes  = { cost_center = ["100"] }
+			realm_roles = [keycloak_role.role.name]
+			children    = %s
+		},
+		{
+			name     = "Sales"
+			children = %s
+		},
+		{
+			name = "Human Resources"
+		},
+	])
+}
+	`, testAccRealm.Realm, roleName, rootName, engineeringChildren, salesChildren)
+}
+
+func testKeycloakGroupTree_siblings(engineeringName, salesName, engineeringChildren, salesChildren string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group_tree" "tree" {
+	realm_id = data.keycloak_realm.realm.id
+
+	groups_json = jsonencode([
+		{
+			name     = "%s"
+			children = %s
+		},
+		{
+			name     = "%s"
+			children = %s
+		},
+	])
+}
+	`, testAccRealm.Realm, engineeringName, engineeringChildren, salesName, salesChildren)
+}
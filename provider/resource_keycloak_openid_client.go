@@ -23,6 +23,19 @@ var (
 	keycloakOpenidClientAuthorizationPolicyEnforcementMode   = []string{"ENFORCING", "PERMISSIVE", "DISABLED"}
 	keycloakOpenidClientResourcePermissionDecisionStrategies = []string{"UNANIMOUS", "AFFIRMATIVE", "CONSENSUS"}
 	keycloakOpenidClientPkceCodeChallengeMethod              = []string{"", "plain", "S256"}
+	keycloakOpenidClientCibaBackchannelTokenDeliveryModes    = []string{"", "poll", "ping"}
+	keycloakOpenidClientCibaAuthRequestSigningAlgorithms     = []string{"", "RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "PS256", "PS384", "PS512"}
+	keycloakOpenidClientRequestObjectRequired                = []string{"", "not required", "request or request_uri", "request only", "request_uri only"}
+
+	// these attributes used to be set through extra_config, which is still accepted with a warning
+	keycloakOpenidClientDeprecatedExtraConfigKeys = map[string]string{
+		"oidc.ciba.grant.enabled":                       "ciba_grant_enabled",
+		"ciba.backchannel.token.delivery.mode":          "ciba_backchannel_token_delivery_mode",
+		"ciba.backchannel.client.notification.endpoint": "ciba_backchannel_client_notification_endpoint",
+		"ciba.backchannel.auth.request.signing.alg":     "ciba_backchannel_auth_request_signing_alg",
+		"require.pushed.authorization.requests":         "require_pushed_authorization_requests",
+		"request.object.required":                       "request_object_required",
+	}
 )
 
 func resourceKeycloakOpenidClient() *schema.Resource {
@@ -344,7 +357,7 @@ func resourceKeycloakOpenidClientSchema() map[string]*schema.Schema {
 		"extra_config": {
 			Type:             schema.TypeMap,
 			Optional:         true,
-			ValidateDiagFunc: validateExtraConfig(reflect.ValueOf(&keycloak.OpenidClientAttributes{}).Elem()),
+			ValidateDiagFunc: validateExtraConfigWithDeprecatedKeys(reflect.ValueOf(&keycloak.OpenidClientAttributes{}).Elem(), keycloakOpenidClientDeprecatedExtraConfigKeys),
 		},
 		"oauth2_device_authorization_grant_enabled": {
 			Type:     schema.TypeBool,
@@ -368,6 +381,36 @@ func resourceKeycloakOpenidClientSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Optional: true,
 		},
+		"ciba_grant_enabled": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"ciba_backchannel_token_delivery_mode": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(keycloakOpenidClientCibaBackchannelTokenDeliveryModes, false),
+		},
+		"ciba_backchannel_client_notification_endpoint": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+		"ciba_backchannel_auth_request_signing_alg": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(keycloakOpenidClientCibaAuthRequestSigningAlgorithms, false),
+		},
+		"require_pushed_authorization_requests": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"request_object_required": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(keycloakOpenidClientRequestObjectRequired, false),
+		},
 		"always_display_in_console": {
 			Type:     schema.TypeBool,
 			Optional: true,
@@ -466,6 +509,12 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 			Oauth2JwtAuthorizationGrantIdp:           data.Get("oauth2_jwt_authorization_grant_idp").(string),
 			DisplayOnConsentScreen:                   types.KeycloakBoolQuoted(data.Get("display_on_consent_screen").(bool)),
 			PostLogoutRedirectUris:                   types.KeycloakSliceHashDelimited(validPostLogoutRedirectUris),
+			CibaGrantEnabled:                         types.KeycloakBoolQuoted(data.Get("ciba_grant_enabled").(bool)),
+			CibaBackchannelTokenDeliveryMode:         data.Get("ciba_backchannel_token_delivery_mode").(string),
+			CibaClientNotificationEndpoint:           data.Get("ciba_backchannel_client_notification_endpoint").(string),
+			CibaBackchannelAuthRequestSigningAlg:     data.Get("ciba_backchannel_auth_request_signing_alg").(string),
+			RequirePushedAuthorizationRequests:       types.KeycloakBoolQuoted(data.Get("require_pushed_authorization_requests").(bool)),
+			RequestObjectRequired:                    data.Get("request_object_required").(string),
 		},
 		ValidRedirectUris:      validRedirectUris,
 		WebOrigins:             webOrigins,
@@ -473,6 +522,8 @@ func getOpenidClientFromData(data *schema.ResourceData) (*keycloak.OpenidClient,
 		AlwaysDisplayInConsole: data.Get("always_display_in_console").(bool),
 	}
 
+	applyDeprecatedExtraConfig(reflect.ValueOf(&openidClient.Attributes).Elem(), openidClient.Attributes.ExtraConfig, keycloakOpenidClientDeprecatedExtraConfigKeys)
+
 	// preserve empty strings for clearable string fields: only set when explicitly in config
 	if nameOk {
 		openidClient.Name = name.(string)
@@ -572,6 +623,11 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 		}
 		serviceAccountUserId = serviceAccountUser.Id
 	}
+	if client.Attributes.ExtraConfig == nil {
+		client.Attributes.ExtraConfig = map[string]interface{}{}
+	}
+	setDeprecatedExtraConfigData(data, reflect.ValueOf(&client.Attributes).Elem(), client.Attributes.ExtraConfig, keycloakOpenidClientDeprecatedExtraConfigKeys)
+
 	data.SetId(client.Id)
 	data.Set("client_id", client.ClientId)
 	data.Set("realm_id", client.RealmId)
@@ -607,6 +663,12 @@ func setOpenidClientData(ctx context.Context, keycloakClient *keycloak.KeycloakC
 	data.Set("oauth2_device_polling_interval", client.Attributes.Oauth2DevicePollingInterval)
 	data.Set("oauth2_jwt_authorization_grant_enabled", client.Attributes.Oauth2JwtAuthorizationGrantEnabled)
 	data.Set("oauth2_jwt_authorization_grant_idp", client.Attributes.Oauth2JwtAuthorizationGrantIdp)
+	data.Set("ciba_grant_enabled", client.Attributes.CibaGrantEnabled)
+	data.Set("ciba_backchannel_token_delivery_mode", client.Attributes.CibaBackchannelTokenDeliveryMode)
+	data.Set("ciba_backchannel_client_notification_endpoint", client.Attributes.CibaClientNotificationEndpoint)
+	data.Set("ciba_backchannel_auth_request_signing_alg", client.Attributes.CibaBackchannelAuthRequestSigningAlg)
+	data.Set("require_pushed_authorization_requests", client.Attributes.RequirePushedAuthorizationRequests)
+	data.Set("request_object_required", client.Attributes.RequestObjectRequired)
 	data.Set("client_offline_session_idle_timeout", client.Attributes.ClientOfflineSessionIdleTimeout)
 	data.Set("client_offline_session_max_lifespan", client.Attributes.ClientOfflineSessionMaxLifespan)
 	data.Set("client_session_idle_timeout", client.Attributes.ClientSessionIdleTimeout)
@@ -0,0 +1,97 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// keycloak_openid_client_not_before revokes all tokens of a client that were issued before the resource was created.
+// Changing the revision (or any other argument) recreates the resource, which moves the not-before policy to the current time again.
+func resourceKeycloakOpenidClientNotBefore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakOpenidClientNotBeforeCreate,
+		ReadContext:   resourceKeycloakOpenidClientNotBeforeRead,
+		DeleteContext: resourceKeycloakOpenidClientNotBeforeDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID (not the client ID) of the client.",
+			},
+			"revision": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary value that, when changed, sets the not-before policy to the current time again.",
+			},
+			"push_revocation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Push the not-before policy to the admin URL of the client.",
+			},
+			"not_before": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The not-before policy that was set, in seconds since the epoch.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakOpenidClientNotBeforeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	clientId := data.Get("client_id").(string)
+
+	err := keycloakClient.SetClientNotBefore(ctx, realmId, clientId, int(time.Now().Unix()))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result *keycloak.GlobalRequestResult
+	if data.Get("push_revocation").(bool) {
+		result, err = keycloakClient.PushClientRevocation(ctx, realmId, clientId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	client, err := keycloakClient.GetGenericClient(ctx, realmId, clientId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId + "/" + clientId)
+	data.Set("not_before", client.NotBefore)
+
+	return append(globalRequestResultDiagnostics(result), resourceKeycloakOpenidClientNotBeforeRead(ctx, data, meta)...)
+}
+
+func resourceKeycloakOpenidClientNotBeforeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	_, err := keycloakClient.GetGenericClient(ctx, data.Get("realm_id").(string), data.Get("client_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakOpenidClientNotBeforeDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// revoked tokens stay revoked
+	return nil
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakOpenidClientNotBefore_basic(t *testing.T) {
+	t.Parallel()
+
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClientNotBefore_basic(clientId, "1", false),
+				Check:  testAccCheckKeycloakOpenidClientNotBeforeMatchesState("keycloak_openid_client_not_before.not_before"),
+			},
+			{
+				Config: testKeycloakOpenidClientNotBefore_basic(clientId, "2", true),
+				Check:  testAccCheckKeycloakOpenidClientNotBeforeMatchesState("keycloak_openid_client_not_before.not_before"),
+			},
+			// updating the client itself must not reset the not-before policy
+			{
+				Config: testKeycloakOpenidClientNotBefore_basic(clientId+"-updated", "2", true),
+				Check:  testAccCheckKeycloakOpenidClientNotBeforeMatchesState("keycloak_openid_client_not_before.not_before"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakOpenidClientNotBeforeMatchesState(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		client, err := keycloakClient.GetGenericClient(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["client_id"])
+		if err != nil {
+			return err
+		}
+
+		notBefore, err := strconv.Atoi(rs.Primary.Attributes["not_before"])
+		if err != nil {
+			return err
+		}
+
+		if notBefore == 0 || client.NotBefore != notBefore {
+			return fmt.Errorf("expected client %s to have not-before policy %d, but was %d", client.ClientId, notBefore, client.NotBefore)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakOpenidClientNotBefore_basic(clientId, revision string, pushRevocation bool) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id    = data.keycloak_realm.realm.id
+	client_id   = "%s"
+	access_type = "CONFIDENTIAL"
+	admin_url   = "http://localhost:1/admin"
+}
+
+resource "keycloak_openid_client_not_before" "not_before" {
+	realm_id        = data.keycloak_realm.realm.id
+	client_id       = keycloak_openid_client.client.id
+	revision        = "%s"
+	push_revocation = %t
+}
+	`, testAccRealm.Realm, clientId, revision, pushRevocation)
+}
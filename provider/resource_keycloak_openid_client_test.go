@@ -1059,6 +1059,62 @@ func TestAccKeycloakOpenidClient_oauth2DeviceAuthorizationGrantEnabled(t *testin
 	})
 }
 
+func TestAccKeycloakOpenidClient_cibaAndPar(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakOpenidClient_cibaAndPar(clientId, "ping", ""),
+				ExpectError: regexp.MustCompile("a client notification endpoint is required when the backchannel token delivery mode is ping"),
+			},
+			{
+				Config: testKeycloakOpenidClient_cibaAndPar(clientId, "poll", ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasCibaAndPar("keycloak_openid_client.client", "poll"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "request_object_required", "request or request_uri"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_cibaAndPar(clientId, "ping", "https://example.com/ciba/notify"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasCibaAndPar("keycloak_openid_client.client", "ping"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "ciba_backchannel_client_notification_endpoint", "https://example.com/ciba/notify"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakOpenidClient_cibaAndParDeprecatedExtraConfig(t *testing.T) {
+	t.Parallel()
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakOpenidClientDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakOpenidClient_cibaAndParDeprecatedExtraConfig(clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakOpenidClientHasCibaAndPar("keycloak_openid_client.client", "poll"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "extra_config.oidc.ciba.grant.enabled", "true"),
+					resource.TestCheckResourceAttr("keycloak_openid_client.client", "ciba_grant_enabled", "false"),
+				),
+			},
+			{
+				Config: testKeycloakOpenidClient_cibaAndPar(clientId, "poll", ""),
+				Check:  testAccCheckKeycloakOpenidClientHasCibaAndPar("keycloak_openid_client.client", "poll"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakOpenidClient_oauth2JwtAuthorizationGrantEnabled(t *testing.T) {
 	if ok, _ := keycloakClient.VersionIsGreaterThanOrEqualTo(testCtx, keycloak.Version_26_6); !ok {
 		t.Skip()
@@ -1728,6 +1784,29 @@ func testAccCheckKeycloakOpenidClientOauth2DeviceAuthorizationGrantEnabled(resou
 	}
 }
 
+func testAccCheckKeycloakOpenidClientHasCibaAndPar(resourceName, tokenDeliveryMode string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := getOpenidClientFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if !client.Attributes.CibaGrantEnabled {
+			return fmt.Errorf("expected openid client to have the CIBA grant enabled")
+		}
+
+		if client.Attributes.CibaBackchannelTokenDeliveryMode != tokenDeliveryMode {
+			return fmt.Errorf("expected openid client to have backchannel token delivery mode %s, but got %s", tokenDeliveryMode, client.Attributes.CibaBackchannelTokenDeliveryMode)
+		}
+
+		if !client.Attributes.RequirePushedAuthorizationRequests {
+			return fmt.Errorf("expected openid client to require pushed authorization requests")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakOpenidClientOauth2JwtAuthorizationGrantEnabled(resourceName string, oauth2JwtAuthorizationGrantEnabled bool) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client, err := getOpenidClientFromState(s, resourceName)
@@ -2428,6 +2507,53 @@ resource "keycloak_openid_client" "client" {
 	`, testAccRealm.Realm, clientId, sb.String())
 }
 
+func testKeycloakOpenidClient_cibaAndParDeprecatedExtraConfig(clientId string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+
+	extra_config = {
+		"oidc.ciba.grant.enabled"               = "true"
+		"ciba.backchannel.token.delivery.mode"  = "poll"
+		"require.pushed.authorization.requests" = "true"
+	}
+}
+	`, testAccRealm.Realm, clientId)
+}
+
+func testKeycloakOpenidClient_cibaAndPar(clientId, tokenDeliveryMode, notificationEndpoint string) string {
+	notificationEndpointConfig := ""
+	if notificationEndpoint != "" {
+		notificationEndpointConfig = fmt.Sprintf(`ciba_backchannel_client_notification_endpoint = "%s"`, notificationEndpoint)
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	client_id   = "%s"
+	realm_id    = data.keycloak_realm.realm.id
+	access_type = "CONFIDENTIAL"
+
+	ciba_grant_enabled                        = true
+	ciba_backchannel_token_delivery_mode      = "%s"
+	ciba_backchannel_auth_request_signing_alg = "RS256"
+	%s
+
+	require_pushed_authorization_requests = true
+	request_object_required               = "request or request_uri"
+}
+	`, testAccRealm.Realm, clientId, tokenDeliveryMode, notificationEndpointConfig)
+}
+
 func testKeycloakOpenidClient_oauth2DeviceAuthorizationGrantEnabled(clientId string, oauth2DeviceAuthorizationGrantEnabled bool) string {
 
 	return fmt.Sprintf(`
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"strconv"
 
 	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -16,10 +17,15 @@ import (
 var (
 	keycloakRealmValidOTPTypes      = []string{"totp", "hotp"}
 	keycloakRealmValidOTPAlgorithms = []string{"HmacSHA1", "HmacSHA256", "HmacSHA512"}
+
+	keycloakRealmValidCibaBackchannelTokenDeliveryModes = []string{"poll", "ping"}
+	keycloakRealmValidCibaAuthRequestedUserHints        = []string{"login_hint"}
 )
 
 const minKeycloakPasskeysVersion = "26.3.5"
 const minKeycloakDiscoverableCredentialVersion = "26.7.0"
+const minKeycloakCibaVersion = "12.0.0"
+const minKeycloakParVersion = "13.0.0"
 
 func resourceKeycloakRealm() *schema.Resource {
 
@@ -182,6 +188,7 @@ func resourceKeycloakRealm() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmRead,
 		DeleteContext: resourceKeycloakRealmDelete,
 		UpdateContext: resourceKeycloakRealmUpdate,
+		CustomizeDiff: resourceKeycloakRealmPasswordPolicyDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -660,9 +667,33 @@ func resourceKeycloakRealm() *schema.Resource {
 
 			// authentication password policy
 			"password_policy": {
-				Type:        schema.TypeString,
-				Description: "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
-				Optional:    true,
+				Type:          schema.TypeString,
+				Description:   "String that represents the passwordPolicies that are in place. Each policy is separated with \" and \". Supported policies can be found in the server-info providers page. example: \"upperCase(1) and length(8) and forceExpiredPasswordChange(365) and notUsername(undefined)\"",
+				Optional:      true,
+				ConflictsWith: []string{"password_policy_rule"},
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return keycloak.NormalizePasswordPolicy(old) == keycloak.NormalizePasswordPolicy(new)
+				},
+			},
+			"password_policy_rule": {
+				Type:          schema.TypeSet,
+				Description:   "Typed alternative to password_policy. Each rule is one password policy provider with its optional value.",
+				Optional:      true,
+				ConflictsWith: []string{"password_policy"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "The id of the password policy provider, e.g. length or passwordHistory.",
+							Required:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "The value of the policy, if it takes one.",
+							Optional:    true,
+						},
+					},
+				},
 			},
 
 			// authentication flow bindings
@@ -768,6 +799,54 @@ func resourceKeycloakRealm() *schema.Resource {
 					Schema: webAuthnPasswordlessSchema,
 				},
 			},
+
+			// CIBA Policy
+			"ciba_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backchannel_token_delivery_mode": {
+							Type:         schema.TypeString,
+							Description:  "How the client receives the tokens, either poll or ping",
+							Optional:     true,
+							Default:      "poll",
+							ValidateFunc: validation.StringInSlice(keycloakRealmValidCibaBackchannelTokenDeliveryModes, false),
+						},
+						"expires_in": {
+							Type:         schema.TypeInt,
+							Description:  "The expiration time of the authentication request in seconds",
+							Optional:     true,
+							Default:      120,
+							ValidateFunc: validation.IntBetween(10, 600),
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Description:  "The minimum amount of time in seconds that the client should wait between polling requests",
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validation.IntBetween(0, 600),
+						},
+						"auth_requested_user_hint": {
+							Type:         schema.TypeString,
+							Description:  "The way of identifying the end-user for whom authentication is being requested",
+							Optional:     true,
+							Default:      "login_hint",
+							ValidateFunc: validation.StringInSlice(keycloakRealmValidCibaAuthRequestedUserHints, false),
+						},
+					},
+				},
+			},
+
+			// Pushed Authorization Requests
+			"par_request_uri_lifespan": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: suppressDurationStringDiff,
+			},
 		},
 	}
 }
@@ -863,23 +942,14 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 		UserManagedAccess:    data.Get("user_managed_access").(bool),
 		OrganizationsEnabled: data.Get("organizations_enabled").(bool),
 
-		// Login Config
-		RegistrationAllowed:         data.Get("registration_allowed").(bool),
-		RegistrationEmailAsUsername: data.Get("registration_email_as_username").(bool),
-		EditUsernameAllowed:         data.Get("edit_username_allowed").(bool),
-		ResetPasswordAllowed:        data.Get("reset_password_allowed").(bool),
-		RememberMe:                  data.Get("remember_me").(bool),
-		VerifyEmail:                 data.Get("verify_email").(bool),
-		LoginWithEmailAllowed:       data.Get("login_with_email_allowed").(bool),
-		DuplicateEmailsAllowed:      data.Get("duplicate_emails_allowed").(bool),
-		SslRequired:                 data.Get("ssl_required").(string),
-
 		//internationalization
 		InternationalizationEnabled: internationalizationEnabled,
 		SupportLocales:              supportLocales,
 		DefaultLocale:               defaultLocale,
 	}
 
+	getRealmLoginSettingsFromData(data, realm)
+
 	// Set string fields only if explicitly provided, preserving empty strings
 	if displayNameOk {
 		realm.DisplayName = displayName.(string)
@@ -932,8 +1002,107 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 		realm.SmtpServer = smtpServer
 	}
 
-	// Themes
+	getRealmThemesFromData(data, realm)
+
+	err := getRealmTokenSettingsFromData(data, realm)
+	if err != nil {
+		return nil, err
+	}
+
+	//security defenses
+	var headersConfig, bruteForceDetectionConfig []interface{}
+	if v, ok := data.GetOk("security_defenses"); ok {
+		securityDefensesSettings := v.([]interface{})[0].(map[string]interface{})
+		headersConfig = securityDefensesSettings["headers"].([]interface{})
+		bruteForceDetectionConfig = securityDefensesSettings["brute_force_detection"].([]interface{})
+	}
+	getRealmSecurityDefensesFromSettings(realm, headersConfig, bruteForceDetectionConfig, keycloakVersion)
+
+	if passwordPolicy, ok := data.GetOk("password_policy"); ok {
+		realm.PasswordPolicy = passwordPolicy.(string)
+	}
+
+	if passwordPolicyRules, ok := data.GetOk("password_policy_rule"); ok {
+		realm.PasswordPolicy = keycloak.FormatPasswordPolicy(getPasswordPolicyRulesFromData(passwordPolicyRules.(*schema.Set)))
+	}
+
+	setRealmFlowBindings(data, realm, keycloakVersion)
+
+	attributes := map[string]interface{}{}
+	if v, ok := data.GetOk("attributes"); ok {
+		for key, value := range v.(map[string]interface{}) {
+			attributes[key] = value
+		}
+	}
+	realm.Attributes = attributes
+
+	err = getRealmCibaAndParPoliciesFromData(data, realm, keycloakVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultDefaultClientScopes := make([]string, 0)
+	if v, ok := data.GetOk("default_default_client_scopes"); ok {
+		for _, defaultDefaultClientScope := range v.(*schema.Set).List() {
+			defaultDefaultClientScopes = append(defaultDefaultClientScopes, defaultDefaultClientScope.(string))
+		}
+	}
+	realm.DefaultDefaultClientScopes = defaultDefaultClientScopes
+
+	defaultOptionalClientScopes := make([]string, 0)
+	if v, ok := data.GetOk("default_optional_client_scopes"); ok {
+		for _, defaultOptionalClientScope := range v.(*schema.Set).List() {
+			defaultOptionalClientScopes = append(defaultOptionalClientScopes, defaultOptionalClientScope.(string))
+		}
+	}
+	realm.DefaultOptionalClientScopes = defaultOptionalClientScopes
+
+	realm.AdminPermissionsEnabled = data.Get("admin_permissions_enabled").(bool)
+
+	//OTPPolicy
+	if v, ok := data.GetOk("otp_policy"); ok {
+		getRealmOtpPolicyFromSettings(realm, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	err = getRealmWebAuthnPoliciesFromData(data, realm, keycloakVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return realm, nil
+}
+
+func getRealmLoginSettingsFromData(data *schema.ResourceData, realm *keycloak.Realm) {
+	if registrationAllowed, ok := data.GetOkExists("registration_allowed"); ok {
+		realm.RegistrationAllowed = registrationAllowed.(bool)
+	}
+	if registrationEmailAsUsername, ok := data.GetOkExists("registration_email_as_username"); ok {
+		realm.RegistrationEmailAsUsername = registrationEmailAsUsername.(bool)
+	}
+	if editUsernameAllowed, ok := data.GetOkExists("edit_username_allowed"); ok {
+		realm.EditUsernameAllowed = editUsernameAllowed.(bool)
+	}
+	if resetPasswordAllowed, ok := data.GetOkExists("reset_password_allowed"); ok {
+		realm.ResetPasswordAllowed = resetPasswordAllowed.(bool)
+	}
+	if rememberMe, ok := data.GetOkExists("remember_me"); ok {
+		realm.RememberMe = rememberMe.(bool)
+	}
+	if verifyEmail, ok := data.GetOkExists("verify_email"); ok {
+		realm.VerifyEmail = verifyEmail.(bool)
+	}
+	if loginWithEmailAllowed, ok := data.GetOkExists("login_with_email_allowed"); ok {
+		realm.LoginWithEmailAllowed = loginWithEmailAllowed.(bool)
+	}
+	if duplicateEmailsAllowed, ok := data.GetOkExists("duplicate_emails_allowed"); ok {
+		realm.DuplicateEmailsAllowed = duplicateEmailsAllowed.(bool)
+	}
+	if sslRequired, ok := data.GetOk("ssl_required"); ok {
+		realm.SslRequired = sslRequired.(string)
+	}
+}
 
+func getRealmThemesFromData(data *schema.ResourceData, realm *keycloak.Realm) {
 	if loginTheme, ok := data.GetOk("login_theme"); ok {
 		realm.LoginTheme = loginTheme.(string)
 	}
@@ -949,9 +1118,9 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if emailTheme, ok := data.GetOk("email_theme"); ok {
 		realm.EmailTheme = emailTheme.(string)
 	}
+}
 
-	// Tokens
-
+func getRealmTokenSettingsFromData(data *schema.ResourceData, realm *keycloak.Realm) error {
 	if defaultSignatureAlgorithm, ok := data.GetOk("default_signature_algorithm"); ok {
 		realm.DefaultSignatureAlgorithm = defaultSignatureAlgorithm.(string)
 	}
@@ -967,7 +1136,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if ssoSessionIdleTimeout := data.Get("sso_session_idle_timeout").(string); ssoSessionIdleTimeout != "" {
 		ssoSessionIdleTimeoutDurationString, err := getSecondsFromDurationString(ssoSessionIdleTimeout)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.SsoSessionIdleTimeout = ssoSessionIdleTimeoutDurationString
 	}
@@ -975,7 +1144,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if ssoSessionMaxLifespan := data.Get("sso_session_max_lifespan").(string); ssoSessionMaxLifespan != "" {
 		ssoSessionMaxLifespanDurationString, err := getSecondsFromDurationString(ssoSessionMaxLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.SsoSessionMaxLifespan = ssoSessionMaxLifespanDurationString
 	}
@@ -983,7 +1152,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if ssoSessionIdleTimeoutRememberMe := data.Get("sso_session_idle_timeout_remember_me").(string); ssoSessionIdleTimeoutRememberMe != "" {
 		ssoSessionIdleTimeoutRememberMeDurationString, err := getSecondsFromDurationString(ssoSessionIdleTimeoutRememberMe)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.SsoSessionIdleTimeoutRememberMe = ssoSessionIdleTimeoutRememberMeDurationString
 	}
@@ -991,7 +1160,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if ssoSessionMaxLifespanRememberMe := data.Get("sso_session_max_lifespan_remember_me").(string); ssoSessionMaxLifespanRememberMe != "" {
 		ssoSessionMaxLifespanRememberMeDurationString, err := getSecondsFromDurationString(ssoSessionMaxLifespanRememberMe)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.SsoSessionMaxLifespanRememberMe = ssoSessionMaxLifespanRememberMeDurationString
 	}
@@ -999,7 +1168,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if offlineSessionIdleTimeout := data.Get("offline_session_idle_timeout").(string); offlineSessionIdleTimeout != "" {
 		offlineSessionIdleTimeoutDurationString, err := getSecondsFromDurationString(offlineSessionIdleTimeout)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.OfflineSessionIdleTimeout = offlineSessionIdleTimeoutDurationString
 	}
@@ -1007,7 +1176,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if offlineSessionMaxLifespan := data.Get("offline_session_max_lifespan").(string); offlineSessionMaxLifespan != "" {
 		offlineSessionMaxLifespanDurationString, err := getSecondsFromDurationString(offlineSessionMaxLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.OfflineSessionMaxLifespan = offlineSessionMaxLifespanDurationString
 	}
@@ -1019,7 +1188,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if clientSessionIdleTimeout := data.Get("client_session_idle_timeout").(string); clientSessionIdleTimeout != "" {
 		clientSessionIdleTimeoutDurationString, err := getSecondsFromDurationString(clientSessionIdleTimeout)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.ClientSessionIdleTimeout = clientSessionIdleTimeoutDurationString
 	}
@@ -1027,7 +1196,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if clientSessionMaxLifespan := data.Get("client_session_max_lifespan").(string); clientSessionMaxLifespan != "" {
 		clientSessionMaxLifespanDurationString, err := getSecondsFromDurationString(clientSessionMaxLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.ClientSessionMaxLifespan = clientSessionMaxLifespanDurationString
 	}
@@ -1035,7 +1204,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if accessTokenLifespan := data.Get("access_token_lifespan").(string); accessTokenLifespan != "" {
 		accessTokenLifespanDurationString, err := getSecondsFromDurationString(accessTokenLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.AccessTokenLifespan = accessTokenLifespanDurationString
 	}
@@ -1043,7 +1212,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if accessTokenLifespanForImplicitFlow := data.Get("access_token_lifespan_for_implicit_flow").(string); accessTokenLifespanForImplicitFlow != "" {
 		accessTokenLifespanForImplicitFlowDurationString, err := getSecondsFromDurationString(accessTokenLifespanForImplicitFlow)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.AccessTokenLifespanForImplicitFlow = accessTokenLifespanForImplicitFlowDurationString
 	}
@@ -1051,7 +1220,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if accessCodeLifespan := data.Get("access_code_lifespan").(string); accessCodeLifespan != "" {
 		accessCodeLifespanDurationString, err := getSecondsFromDurationString(accessCodeLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.AccessCodeLifespan = accessCodeLifespanDurationString
 	}
@@ -1059,7 +1228,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if accessCodeLifespanLogin := data.Get("access_code_lifespan_login").(string); accessCodeLifespanLogin != "" {
 		accessCodeLifespanLoginDurationString, err := getSecondsFromDurationString(accessCodeLifespanLogin)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.AccessCodeLifespanLogin = accessCodeLifespanLoginDurationString
 	}
@@ -1067,7 +1236,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if accessCodeLifespanUserAction := data.Get("access_code_lifespan_user_action").(string); accessCodeLifespanUserAction != "" {
 		accessCodeLifespanUserActionDurationString, err := getSecondsFromDurationString(accessCodeLifespanUserAction)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.AccessCodeLifespanUserAction = accessCodeLifespanUserActionDurationString
 	}
@@ -1075,7 +1244,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if actionTokenGeneratedByUserLifespan := data.Get("action_token_generated_by_user_lifespan").(string); actionTokenGeneratedByUserLifespan != "" {
 		actionTokenGeneratedByUserLifespanDurationString, err := getSecondsFromDurationString(actionTokenGeneratedByUserLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.ActionTokenGeneratedByUserLifespan = actionTokenGeneratedByUserLifespanDurationString
 	}
@@ -1083,7 +1252,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if actionTokenGeneratedByAdminLifespan := data.Get("action_token_generated_by_admin_lifespan").(string); actionTokenGeneratedByAdminLifespan != "" {
 		actionTokenGeneratedByAdminLifespanDurationString, err := getSecondsFromDurationString(actionTokenGeneratedByAdminLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.ActionTokenGeneratedByAdminLifespan = actionTokenGeneratedByAdminLifespanDurationString
 	}
@@ -1091,7 +1260,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 	if oauth2DeviceCodeLifespan := data.Get("oauth2_device_code_lifespan").(string); oauth2DeviceCodeLifespan != "" {
 		oauth2DeviceCodeLifespanDurationString, err := getSecondsFromDurationString(oauth2DeviceCodeLifespan)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		realm.Oauth2DeviceCodeLifespan = oauth2DeviceCodeLifespanDurationString
 	}
@@ -1100,114 +1269,75 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 		realm.Oauth2DevicePollingInterval = oauth2DevicePollingInterval.(int)
 	}
 
-	//security defenses
-	if v, ok := data.GetOk("security_defenses"); ok {
-		securityDefensesSettings := v.([]interface{})[0].(map[string]interface{})
-
-		headersConfig := securityDefensesSettings["headers"].([]interface{})
-		if len(headersConfig) == 1 {
-			headerSettings := headersConfig[0].(map[string]interface{})
-
-			realm.BrowserSecurityHeaders = keycloak.BrowserSecurityHeaders{
-				ContentSecurityPolicy:           headerSettings["content_security_policy"].(string),
-				ContentSecurityPolicyReportOnly: headerSettings["content_security_policy_report_only"].(string),
-				StrictTransportSecurity:         headerSettings["strict_transport_security"].(string),
-				XContentTypeOptions:             headerSettings["x_content_type_options"].(string),
-				XFrameOptions:                   headerSettings["x_frame_options"].(string),
-				XRobotsTag:                      headerSettings["x_robots_tag"].(string),
-				XXSSProtection:                  headerSettings["x_xss_protection"].(string),
-				ReferrerPolicy:                  headerSettings["referrer_policy"].(string),
-			}
-		} else {
-			setDefaultSecuritySettingHeaders(realm)
-		}
+	return nil
+}
 
-		bruteForceDetectionConfig := securityDefensesSettings["brute_force_detection"].([]interface{})
-		if len(bruteForceDetectionConfig) == 1 {
-			bruteForceDetectionSettings := bruteForceDetectionConfig[0].(map[string]interface{})
-			realm.BruteForceProtected = true
-			realm.PermanentLockout = bruteForceDetectionSettings["permanent_lockout"].(bool)
-			realm.BruteForceStrategy = bruteForceDetectionSettings["brute_force_strategy"].(string)
-			realm.FailureFactor = bruteForceDetectionSettings["max_login_failures"].(int)
-			realm.WaitIncrementSeconds = bruteForceDetectionSettings["wait_increment_seconds"].(int)
-			realm.QuickLoginCheckMilliSeconds = bruteForceDetectionSettings["quick_login_check_milli_seconds"].(int)
-			realm.MinimumQuickLoginWaitSeconds = bruteForceDetectionSettings["minimum_quick_login_wait_seconds"].(int)
-			realm.MaxFailureWaitSeconds = bruteForceDetectionSettings["max_failure_wait_seconds"].(int)
-			realm.MaxDeltaTimeSeconds = bruteForceDetectionSettings["failure_reset_time_seconds"].(int)
-			realm.MaxTemporaryLockouts = bruteForceDetectionSettings["max_temporary_lockouts"].(int)
-		} else {
-			setDefaultSecuritySettingsBruteForceDetection(realm, keycloakVersion)
+func getRealmSecurityDefensesFromSettings(realm *keycloak.Realm, headersConfig, bruteForceDetectionConfig []interface{}, keycloakVersion *version.Version) {
+	if len(headersConfig) == 1 {
+		headerSettings := headersConfig[0].(map[string]interface{})
+
+		realm.BrowserSecurityHeaders = keycloak.BrowserSecurityHeaders{
+			ContentSecurityPolicy:           headerSettings["content_security_policy"].(string),
+			ContentSecurityPolicyReportOnly: headerSettings["content_security_policy_report_only"].(string),
+			StrictTransportSecurity:         headerSettings["strict_transport_security"].(string),
+			XContentTypeOptions:             headerSettings["x_content_type_options"].(string),
+			XFrameOptions:                   headerSettings["x_frame_options"].(string),
+			XRobotsTag:                      headerSettings["x_robots_tag"].(string),
+			XXSSProtection:                  headerSettings["x_xss_protection"].(string),
+			ReferrerPolicy:                  headerSettings["referrer_policy"].(string),
 		}
 	} else {
 		setDefaultSecuritySettingHeaders(realm)
-		setDefaultSecuritySettingsBruteForceDetection(realm, keycloakVersion)
 	}
 
-	if passwordPolicy, ok := data.GetOk("password_policy"); ok {
-		realm.PasswordPolicy = passwordPolicy.(string)
+	if len(bruteForceDetectionConfig) == 1 {
+		bruteForceDetectionSettings := bruteForceDetectionConfig[0].(map[string]interface{})
+		realm.BruteForceProtected = true
+		realm.PermanentLockout = bruteForceDetectionSettings["permanent_lockout"].(bool)
+		realm.BruteForceStrategy = bruteForceDetectionSettings["brute_force_strategy"].(string)
+		realm.FailureFactor = bruteForceDetectionSettings["max_login_failures"].(int)
+		realm.WaitIncrementSeconds = bruteForceDetectionSettings["wait_increment_seconds"].(int)
+		realm.QuickLoginCheckMilliSeconds = bruteForceDetectionSettings["quick_login_check_milli_seconds"].(int)
+		realm.MinimumQuickLoginWaitSeconds = bruteForceDetectionSettings["minimum_quick_login_wait_seconds"].(int)
+		realm.MaxFailureWaitSeconds = bruteForceDetectionSettings["max_failure_wait_seconds"].(int)
+		realm.MaxDeltaTimeSeconds = bruteForceDetectionSettings["failure_reset_time_seconds"].(int)
+		realm.MaxTemporaryLockouts = bruteForceDetectionSettings["max_temporary_lockouts"].(int)
+	} else {
+		setDefaultSecuritySettingsBruteForceDetection(realm, keycloakVersion)
 	}
+}
 
-	setRealmFlowBindings(data, realm, keycloakVersion)
-
-	attributes := map[string]interface{}{}
-	if v, ok := data.GetOk("attributes"); ok {
-		for key, value := range v.(map[string]interface{}) {
-			attributes[key] = value
-		}
+func getRealmOtpPolicyFromSettings(realm *keycloak.Realm, otpPolicy map[string]interface{}) {
+	if otpPolicyAlgorithm, ok := otpPolicy["algorithm"]; ok {
+		realm.OTPPolicyAlgorithm = otpPolicyAlgorithm.(string)
 	}
-	realm.Attributes = attributes
 
-	defaultDefaultClientScopes := make([]string, 0)
-	if v, ok := data.GetOk("default_default_client_scopes"); ok {
-		for _, defaultDefaultClientScope := range v.(*schema.Set).List() {
-			defaultDefaultClientScopes = append(defaultDefaultClientScopes, defaultDefaultClientScope.(string))
-		}
+	if otpPolicyDigits, ok := otpPolicy["digits"]; ok {
+		realm.OTPPolicyDigits = otpPolicyDigits.(int)
 	}
-	realm.DefaultDefaultClientScopes = defaultDefaultClientScopes
 
-	defaultOptionalClientScopes := make([]string, 0)
-	if v, ok := data.GetOk("default_optional_client_scopes"); ok {
-		for _, defaultOptionalClientScope := range v.(*schema.Set).List() {
-			defaultOptionalClientScopes = append(defaultOptionalClientScopes, defaultOptionalClientScope.(string))
-		}
+	if otpPolicyInitialCounter, ok := otpPolicy["initial_counter"]; ok {
+		realm.OTPPolicyInitialCounter = otpPolicyInitialCounter.(int)
 	}
-	realm.DefaultOptionalClientScopes = defaultOptionalClientScopes
 
-	realm.AdminPermissionsEnabled = data.Get("admin_permissions_enabled").(bool)
-
-	//OTPPolicy
-	if v, ok := data.GetOk("otp_policy"); ok {
-		otpPolicy := v.([]interface{})[0].(map[string]interface{})
-
-		if otpPolicyAlgorithm, ok := otpPolicy["algorithm"]; ok {
-			realm.OTPPolicyAlgorithm = otpPolicyAlgorithm.(string)
-		}
-
-		if otpPolicyDigits, ok := otpPolicy["digits"]; ok {
-			realm.OTPPolicyDigits = otpPolicyDigits.(int)
-		}
-
-		if otpPolicyInitialCounter, ok := otpPolicy["initial_counter"]; ok {
-			realm.OTPPolicyInitialCounter = otpPolicyInitialCounter.(int)
-		}
-
-		if otpPolicyLookAheadWindow, ok := otpPolicy["look_ahead_window"]; ok {
-			realm.OTPPolicyLookAheadWindow = otpPolicyLookAheadWindow.(int)
-		}
+	if otpPolicyLookAheadWindow, ok := otpPolicy["look_ahead_window"]; ok {
+		realm.OTPPolicyLookAheadWindow = otpPolicyLookAheadWindow.(int)
+	}
 
-		if otpPolicyPeriod, ok := otpPolicy["period"]; ok {
-			realm.OTPPolicyPeriod = otpPolicyPeriod.(int)
-		}
+	if otpPolicyPeriod, ok := otpPolicy["period"]; ok {
+		realm.OTPPolicyPeriod = otpPolicyPeriod.(int)
+	}
 
-		if otpPolicyCodeReusable, ok := otpPolicy["code_reusable"]; ok {
-			realm.OTPPolicyCodeReusable = otpPolicyCodeReusable.(bool)
-		}
+	if otpPolicyCodeReusable, ok := otpPolicy["code_reusable"]; ok {
+		realm.OTPPolicyCodeReusable = otpPolicyCodeReusable.(bool)
+	}
 
-		if otpPolicyType, ok := otpPolicy["type"]; ok {
-			realm.OTPPolicyType = otpPolicyType.(string)
-		}
+	if otpPolicyType, ok := otpPolicy["type"]; ok {
+		realm.OTPPolicyType = otpPolicyType.(string)
 	}
+}
 
+func getRealmWebAuthnPoliciesFromData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVersion *version.Version) error {
 	//WebAuthn
 	if v, ok := data.GetOk("web_authn_policy"); ok {
 		webAuthnPolicy := v.([]interface{})[0].(map[string]interface{})
@@ -1240,7 +1370,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 			if supportsDiscoverableCredential(keycloakVersion) {
 				realm.WebAuthnPolicyDiscoverableCredential = discoverableCredential
 			} else if discoverableCredential != "" && discoverableCredential != "not specified" {
-				return nil, fmt.Errorf("discoverable_credential in web_authn_policy for realm \"%s\" is not supported by your Keycloak version (requires >= %s)", realm.Id, minKeycloakDiscoverableCredentialVersion)
+				return fmt.Errorf("discoverable_credential in web_authn_policy for realm \"%s\" is not supported by your Keycloak version (requires >= %s)", realm.Id, minKeycloakDiscoverableCredentialVersion)
 			}
 		}
 
@@ -1290,7 +1420,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 				realm.WebAuthnPolicyPasswordlessPasskeysEnabled = &passkeysEnabled
 			}
 		} else if _, ok := data.GetOk("web_authn_passwordless_policy.0.passwordless_passkeys_enabled"); ok {
-			return nil, fmt.Errorf("passwordless_passkeys_enabled in web_authn_passwordless_policy for realm \"%s\" is not supported by your Keycloak version (requires >= 26.3.5)", realm.Id)
+			return fmt.Errorf("passwordless_passkeys_enabled in web_authn_passwordless_policy for realm \"%s\" is not supported by your Keycloak version (requires >= 26.3.5)", realm.Id)
 		}
 
 		if webAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister, ok := webAuthnPasswordlessPolicy["avoid_same_authenticator_register"]; ok {
@@ -1310,7 +1440,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 			if supportsDiscoverableCredential(keycloakVersion) {
 				realm.WebAuthnPolicyPasswordlessDiscoverableCredential = discoverableCredential
 			} else if discoverableCredential != "" && discoverableCredential != "not specified" {
-				return nil, fmt.Errorf("discoverable_credential in web_authn_passwordless_policy for realm \"%s\" is not supported by your Keycloak version (requires >= %s)", realm.Id, minKeycloakDiscoverableCredentialVersion)
+				return fmt.Errorf("discoverable_credential in web_authn_passwordless_policy for realm \"%s\" is not supported by your Keycloak version (requires >= %s)", realm.Id, minKeycloakDiscoverableCredentialVersion)
 			}
 		}
 
@@ -1329,7 +1459,7 @@ func getRealmFromData(data *schema.ResourceData, keycloakVersion *version.Versio
 		}
 	}
 
-	return realm, nil
+	return nil
 }
 
 func supportsDiscoverableCredential(keycloakVersion *version.Version) bool {
@@ -1383,16 +1513,7 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 	data.Set("organizations_enabled", realm.OrganizationsEnabled)
 	data.Set("admin_permissions_enabled", realm.AdminPermissionsEnabled)
 
-	// Login Config
-	data.Set("registration_allowed", realm.RegistrationAllowed)
-	data.Set("registration_email_as_username", realm.RegistrationEmailAsUsername)
-	data.Set("edit_username_allowed", realm.EditUsernameAllowed)
-	data.Set("reset_password_allowed", realm.ResetPasswordAllowed)
-	data.Set("remember_me", realm.RememberMe)
-	data.Set("verify_email", realm.VerifyEmail)
-	data.Set("login_with_email_allowed", realm.LoginWithEmailAllowed)
-	data.Set("duplicate_emails_allowed", realm.DuplicateEmailsAllowed)
-	data.Set("ssl_required", realm.SslRequired)
+	setRealmLoginSettingsData(data, realm)
 
 	// Smtp Config
 
@@ -1436,34 +1557,8 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 		data.Set("smtp_server", []interface{}{smtpSettings})
 	}
 
-	// Themes
-	data.Set("login_theme", realm.LoginTheme)
-	data.Set("account_theme", realm.AccountTheme)
-	data.Set("admin_theme", realm.AdminTheme)
-	data.Set("email_theme", realm.EmailTheme)
-
-	// Tokens
-	data.Set("default_signature_algorithm", realm.DefaultSignatureAlgorithm)
-	data.Set("revoke_refresh_token", realm.RevokeRefreshToken)
-	data.Set("refresh_token_max_reuse", realm.RefreshTokenMaxReuse)
-	data.Set("sso_session_idle_timeout", getDurationStringFromSeconds(realm.SsoSessionIdleTimeout))
-	data.Set("sso_session_max_lifespan", getDurationStringFromSeconds(realm.SsoSessionMaxLifespan))
-	data.Set("sso_session_idle_timeout_remember_me", getDurationStringFromSeconds(realm.SsoSessionIdleTimeoutRememberMe))
-	data.Set("sso_session_max_lifespan_remember_me", getDurationStringFromSeconds(realm.SsoSessionMaxLifespanRememberMe))
-	data.Set("offline_session_idle_timeout", getDurationStringFromSeconds(realm.OfflineSessionIdleTimeout))
-	data.Set("offline_session_max_lifespan", getDurationStringFromSeconds(realm.OfflineSessionMaxLifespan))
-	data.Set("offline_session_max_lifespan_enabled", realm.OfflineSessionMaxLifespanEnabled)
-	data.Set("client_session_idle_timeout", getDurationStringFromSeconds(realm.ClientSessionIdleTimeout))
-	data.Set("client_session_max_lifespan", getDurationStringFromSeconds(realm.ClientSessionMaxLifespan))
-	data.Set("access_token_lifespan", getDurationStringFromSeconds(realm.AccessTokenLifespan))
-	data.Set("access_token_lifespan_for_implicit_flow", getDurationStringFromSeconds(realm.AccessTokenLifespanForImplicitFlow))
-	data.Set("access_code_lifespan", getDurationStringFromSeconds(realm.AccessCodeLifespan))
-	data.Set("access_code_lifespan_login", getDurationStringFromSeconds(realm.AccessCodeLifespanLogin))
-	data.Set("access_code_lifespan_user_action", getDurationStringFromSeconds(realm.AccessCodeLifespanUserAction))
-	data.Set("action_token_generated_by_user_lifespan", getDurationStringFromSeconds(realm.ActionTokenGeneratedByUserLifespan))
-	data.Set("action_token_generated_by_admin_lifespan", getDurationStringFromSeconds(realm.ActionTokenGeneratedByAdminLifespan))
-	data.Set("oauth2_device_code_lifespan", getDurationStringFromSeconds(realm.Oauth2DeviceCodeLifespan))
-	data.Set("oauth2_device_polling_interval", realm.Oauth2DevicePollingInterval)
+	setRealmThemesData(data, realm)
+	setRealmTokenSettingsData(data, realm)
 
 	//internationalization
 	if realm.InternationalizationEnabled {
@@ -1495,7 +1590,7 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 		}
 	}
 
-	data.Set("password_policy", realm.PasswordPolicy)
+	setRealmPasswordPolicyData(data, realm.PasswordPolicy)
 
 	//Flow Bindings
 	data.Set("browser_flow", realm.BrowserFlow)
@@ -1506,6 +1601,83 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 	data.Set("docker_authentication_flow", realm.DockerAuthenticationFlow)
 	data.Set("first_broker_login_flow", realm.FirstBrokerLoginFlow)
 
+	setRealmWebAuthnPoliciesData(data, realm, keycloakVersion)
+
+	//OTP Policy
+	data.Set("otp_policy", []interface{}{getRealmOtpPolicySettings(realm)})
+
+	attributes := map[string]interface{}{}
+	if v, ok := data.GetOk("attributes"); ok {
+		for key := range v.(map[string]interface{}) {
+			attributes[key] = realm.Attributes[key]
+			//We are only interested in attributes managed in terraform (Keycloak returns a lot of doubles values in the attributes...)
+		}
+	}
+	data.Set("attributes", attributes)
+
+	setRealmCibaAndParPoliciesData(data, realm)
+
+	// default and optional client scope mappings
+	data.Set("default_default_client_scopes", realm.DefaultDefaultClientScopes)
+	data.Set("default_optional_client_scopes", realm.DefaultOptionalClientScopes)
+}
+
+func setRealmLoginSettingsData(data *schema.ResourceData, realm *keycloak.Realm) {
+	data.Set("registration_allowed", realm.RegistrationAllowed)
+	data.Set("registration_email_as_username", realm.RegistrationEmailAsUsername)
+	data.Set("edit_username_allowed", realm.EditUsernameAllowed)
+	data.Set("reset_password_allowed", realm.ResetPasswordAllowed)
+	data.Set("remember_me", realm.RememberMe)
+	data.Set("verify_email", realm.VerifyEmail)
+	data.Set("login_with_email_allowed", realm.LoginWithEmailAllowed)
+	data.Set("duplicate_emails_allowed", realm.DuplicateEmailsAllowed)
+	data.Set("ssl_required", realm.SslRequired)
+}
+
+func setRealmThemesData(data *schema.ResourceData, realm *keycloak.Realm) {
+	data.Set("login_theme", realm.LoginTheme)
+	data.Set("account_theme", realm.AccountTheme)
+	data.Set("admin_theme", realm.AdminTheme)
+	data.Set("email_theme", realm.EmailTheme)
+}
+
+func setRealmTokenSettingsData(data *schema.ResourceData, realm *keycloak.Realm) {
+	data.Set("default_signature_algorithm", realm.DefaultSignatureAlgorithm)
+	data.Set("revoke_refresh_token", realm.RevokeRefreshToken)
+	data.Set("refresh_token_max_reuse", realm.RefreshTokenMaxReuse)
+	data.Set("sso_session_idle_timeout", getDurationStringFromSeconds(realm.SsoSessionIdleTimeout))
+	data.Set("sso_session_max_lifespan", getDurationStringFromSeconds(realm.SsoSessionMaxLifespan))
+	data.Set("sso_session_idle_timeout_remember_me", getDurationStringFromSeconds(realm.SsoSessionIdleTimeoutRememberMe))
+	data.Set("sso_session_max_lifespan_remember_me", getDurationStringFromSeconds(realm.SsoSessionMaxLifespanRememberMe))
+	data.Set("offline_session_idle_timeout", getDurationStringFromSeconds(realm.OfflineSessionIdleTimeout))
+	data.Set("offline_session_max_lifespan", getDurationStringFromSeconds(realm.OfflineSessionMaxLifespan))
+	data.Set("offline_session_max_lifespan_enabled", realm.OfflineSessionMaxLifespanEnabled)
+	data.Set("client_session_idle_timeout", getDurationStringFromSeconds(realm.ClientSessionIdleTimeout))
+	data.Set("client_session_max_lifespan", getDurationStringFromSeconds(realm.ClientSessionMaxLifespan))
+	data.Set("access_token_lifespan", getDurationStringFromSeconds(realm.AccessTokenLifespan))
+	data.Set("access_token_lifespan_for_implicit_flow", getDurationStringFromSeconds(realm.AccessTokenLifespanForImplicitFlow))
+	data.Set("access_code_lifespan", getDurationStringFromSeconds(realm.AccessCodeLifespan))
+	data.Set("access_code_lifespan_login", getDurationStringFromSeconds(realm.AccessCodeLifespanLogin))
+	data.Set("access_code_lifespan_user_action", getDurationStringFromSeconds(realm.AccessCodeLifespanUserAction))
+	data.Set("action_token_generated_by_user_lifespan", getDurationStringFromSeconds(realm.ActionTokenGeneratedByUserLifespan))
+	data.Set("action_token_generated_by_admin_lifespan", getDurationStringFromSeconds(realm.ActionTokenGeneratedByAdminLifespan))
+	data.Set("oauth2_device_code_lifespan", getDurationStringFromSeconds(realm.Oauth2DeviceCodeLifespan))
+	data.Set("oauth2_device_polling_interval", realm.Oauth2DevicePollingInterval)
+}
+
+func getRealmOtpPolicySettings(realm *keycloak.Realm) map[string]interface{} {
+	otpPolicy := make(map[string]interface{})
+	otpPolicy["type"] = realm.OTPPolicyType
+	otpPolicy["algorithm"] = realm.OTPPolicyAlgorithm
+	otpPolicy["digits"] = realm.OTPPolicyDigits
+	otpPolicy["initial_counter"] = realm.OTPPolicyInitialCounter
+	otpPolicy["look_ahead_window"] = realm.OTPPolicyLookAheadWindow
+	otpPolicy["period"] = realm.OTPPolicyPeriod
+	otpPolicy["code_reusable"] = realm.OTPPolicyCodeReusable
+	return otpPolicy
+}
+
+func setRealmWebAuthnPoliciesData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVersion *version.Version) {
 	//WebAuthn
 	webAuthnPolicy := make(map[string]interface{})
 	webAuthnPolicy["acceptable_aaguids"] = realm.WebAuthnPolicyAcceptableAaguids
@@ -1522,17 +1694,6 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 	webAuthnPolicy["user_verification_requirement"] = realm.WebAuthnPolicyUserVerificationRequirement
 	data.Set("web_authn_policy", []interface{}{webAuthnPolicy})
 
-	//OTP Policy
-	otpPolicy := make(map[string]interface{})
-	otpPolicy["type"] = realm.OTPPolicyType
-	otpPolicy["algorithm"] = realm.OTPPolicyAlgorithm
-	otpPolicy["digits"] = realm.OTPPolicyDigits
-	otpPolicy["initial_counter"] = realm.OTPPolicyInitialCounter
-	otpPolicy["look_ahead_window"] = realm.OTPPolicyLookAheadWindow
-	otpPolicy["period"] = realm.OTPPolicyPeriod
-	otpPolicy["code_reusable"] = realm.OTPPolicyCodeReusable
-	data.Set("otp_policy", []interface{}{otpPolicy})
-
 	//WebAuthn Passwordless
 	webAuthnPasswordlessPolicy := make(map[string]interface{})
 	webAuthnPasswordlessPolicy["acceptable_aaguids"] = realm.WebAuthnPolicyPasswordlessAcceptableAaguids
@@ -1559,19 +1720,250 @@ func setRealmData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVers
 	}
 
 	data.Set("web_authn_passwordless_policy", []interface{}{webAuthnPasswordlessPolicy})
+}
 
-	attributes := map[string]interface{}{}
-	if v, ok := data.GetOk("attributes"); ok {
-		for key := range v.(map[string]interface{}) {
-			attributes[key] = realm.Attributes[key]
-			//We are only interested in attributes managed in terraform (Keycloak returns a lot of doubles values in the attributes...)
+// The settings below can also be managed by the standalone realm sub-resources. When the realm resource is updated,
+// any of these slices that did not change are taken from the server instead, so the realm does not revert values that
+// were written by a sub-resource.
+var (
+	realmLoginSettingsKeys = []string{"registration_allowed", "registration_email_as_username", "edit_username_allowed", "reset_password_allowed", "remember_me", "verify_email", "login_with_email_allowed", "duplicate_emails_allowed", "ssl_required"}
+	realmThemesKeys        = []string{"login_theme", "account_theme", "admin_theme", "email_theme"}
+	realmTokenSettingsKeys = []string{
+		"default_signature_algorithm", "revoke_refresh_token", "refresh_token_max_reuse",
+		"sso_session_idle_timeout", "sso_session_max_lifespan", "sso_session_idle_timeout_remember_me", "sso_session_max_lifespan_remember_me",
+		"offline_session_idle_timeout", "offline_session_max_lifespan", "offline_session_max_lifespan_enabled",
+		"client_session_idle_timeout", "client_session_max_lifespan",
+		"access_token_lifespan", "access_token_lifespan_for_implicit_flow",
+		"access_code_lifespan", "access_code_lifespan_login", "access_code_lifespan_user_action",
+		"action_token_generated_by_user_lifespan", "action_token_generated_by_admin_lifespan",
+		"oauth2_device_code_lifespan", "oauth2_device_polling_interval",
+	}
+	realmSecurityDefensesKeys = []string{"security_defenses"}
+	realmOtpPolicyKeys        = []string{"otp_policy"}
+	realmWebAuthnPolicyKeys   = []string{"web_authn_policy", "web_authn_passwordless_policy"}
+)
+
+// realmSettingsSchema builds the schema of a realm sub-resource from a realm_id attribute and the given keycloak_realm attributes
+func realmSettingsSchema(keys ...string) map[string]*schema.Schema {
+	realmSchema := resourceKeycloakRealm().Schema
+
+	settingsSchema := map[string]*schema.Schema{
+		"realm_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+	for _, key := range keys {
+		settingsSchema[key] = realmSchema[key]
+	}
+
+	return settingsSchema
+}
+
+// updateRealmSettings applies a slice of settings to the current representation of the realm and writes it back
+func updateRealmSettings(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, apply func(realm *keycloak.Realm) error) error {
+	keycloakClient.Mutex.Lock(fmt.Sprintf("keycloakRealm:%s", realmId))
+	defer keycloakClient.Mutex.Unlock(fmt.Sprintf("keycloakRealm:%s", realmId))
+
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return err
+	}
+
+	err = apply(realm)
+	if err != nil {
+		return err
+	}
+
+	err = keycloakClient.ValidateRealm(ctx, realm)
+	if err != nil {
+		return err
+	}
+
+	return keycloakClient.UpdateRealm(ctx, realm)
+}
+
+// the realm sub-resources don't own the realm, so deleting them only removes them from the state
+func resourceKeycloakRealmSettingsDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceKeycloakRealmSettingsImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func preserveUnchangedRealmSettings(data *schema.ResourceData, realm, currentRealm *keycloak.Realm) {
+	if !data.HasChanges(realmLoginSettingsKeys...) {
+		copyRealmLoginSettings(realm, currentRealm)
+	}
+	if !data.HasChanges(realmThemesKeys...) {
+		copyRealmThemes(realm, currentRealm)
+	}
+	if !data.HasChanges(realmTokenSettingsKeys...) {
+		copyRealmTokenSettings(realm, currentRealm)
+	}
+	if !data.HasChanges(realmSecurityDefensesKeys...) {
+		copyRealmSecurityDefenses(realm, currentRealm)
+	}
+	if !data.HasChanges(realmOtpPolicyKeys...) {
+		copyRealmOtpPolicy(realm, currentRealm)
+	}
+	if !data.HasChanges(realmWebAuthnPolicyKeys...) {
+		copyRealmWebAuthnPolicies(realm, currentRealm)
+	}
+}
+
+func copyRealmLoginSettings(dst, src *keycloak.Realm) {
+	dst.RegistrationAllowed = src.RegistrationAllowed
+	dst.RegistrationEmailAsUsername = src.RegistrationEmailAsUsername
+	dst.EditUsernameAllowed = src.EditUsernameAllowed
+	dst.ResetPasswordAllowed = src.ResetPasswordAllowed
+	dst.RememberMe = src.RememberMe
+	dst.VerifyEmail = src.VerifyEmail
+	dst.LoginWithEmailAllowed = src.LoginWithEmailAllowed
+	dst.DuplicateEmailsAllowed = src.DuplicateEmailsAllowed
+	dst.SslRequired = src.SslRequired
+}
+
+func copyRealmThemes(dst, src *keycloak.Realm) {
+	dst.LoginTheme = src.LoginTheme
+	dst.AccountTheme = src.AccountTheme
+	dst.AdminTheme = src.AdminTheme
+	dst.EmailTheme = src.EmailTheme
+}
+
+func copyRealmTokenSettings(dst, src *keycloak.Realm) {
+	dst.DefaultSignatureAlgorithm = src.DefaultSignatureAlgorithm
+	dst.RevokeRefreshToken = src.RevokeRefreshToken
+	dst.RefreshTokenMaxReuse = src.RefreshTokenMaxReuse
+	dst.SsoSessionIdleTimeout = src.SsoSessionIdleTimeout
+	dst.SsoSessionMaxLifespan = src.SsoSessionMaxLifespan
+	dst.SsoSessionIdleTimeoutRememberMe = src.SsoSessionIdleTimeoutRememberMe
+	dst.SsoSessionMaxLifespanRememberMe = src.SsoSessionMaxLifespanRememberMe
+	dst.OfflineSessionIdleTimeout = src.OfflineSessionIdleTimeout
+	dst.OfflineSessionMaxLifespan = src.OfflineSessionMaxLifespan
+	dst.OfflineSessionMaxLifespanEnabled = src.OfflineSessionMaxLifespanEnabled
+	dst.ClientSessionIdleTimeout = src.ClientSessionIdleTimeout
+	dst.ClientSessionMaxLifespan = src.ClientSessionMaxLifespan
+	dst.AccessTokenLifespan = src.AccessTokenLifespan
+	dst.AccessTokenLifespanForImplicitFlow = src.AccessTokenLifespanForImplicitFlow
+	dst.AccessCodeLifespan = src.AccessCodeLifespan
+	dst.AccessCodeLifespanLogin = src.AccessCodeLifespanLogin
+	dst.AccessCodeLifespanUserAction = src.AccessCodeLifespanUserAction
+	dst.ActionTokenGeneratedByUserLifespan = src.ActionTokenGeneratedByUserLifespan
+	dst.ActionTokenGeneratedByAdminLifespan = src.ActionTokenGeneratedByAdminLifespan
+	dst.Oauth2DeviceCodeLifespan = src.Oauth2DeviceCodeLifespan
+	dst.Oauth2DevicePollingInterval = src.Oauth2DevicePollingInterval
+}
+
+func copyRealmSecurityDefenses(dst, src *keycloak.Realm) {
+	dst.BrowserSecurityHeaders = src.BrowserSecurityHeaders
+	dst.BruteForceProtected = src.BruteForceProtected
+	dst.PermanentLockout = src.PermanentLockout
+	dst.BruteForceStrategy = src.BruteForceStrategy
+	dst.FailureFactor = src.FailureFactor
+	dst.WaitIncrementSeconds = src.WaitIncrementSeconds
+	dst.QuickLoginCheckMilliSeconds = src.QuickLoginCheckMilliSeconds
+	dst.MinimumQuickLoginWaitSeconds = src.MinimumQuickLoginWaitSeconds
+	dst.MaxFailureWaitSeconds = src.MaxFailureWaitSeconds
+	dst.MaxDeltaTimeSeconds = src.MaxDeltaTimeSeconds
+	dst.MaxTemporaryLockouts = src.MaxTemporaryLockouts
+}
+
+func copyRealmOtpPolicy(dst, src *keycloak.Realm) {
+	dst.OTPPolicyAlgorithm = src.OTPPolicyAlgorithm
+	dst.OTPPolicyDigits = src.OTPPolicyDigits
+	dst.OTPPolicyInitialCounter = src.OTPPolicyInitialCounter
+	dst.OTPPolicyLookAheadWindow = src.OTPPolicyLookAheadWindow
+	dst.OTPPolicyPeriod = src.OTPPolicyPeriod
+	dst.OTPPolicyCodeReusable = src.OTPPolicyCodeReusable
+	dst.OTPPolicyType = src.OTPPolicyType
+}
+
+func copyRealmWebAuthnPolicies(dst, src *keycloak.Realm) {
+	dst.WebAuthnPolicyAcceptableAaguids = src.WebAuthnPolicyAcceptableAaguids
+	dst.WebAuthnPolicyExtraOrigins = src.WebAuthnPolicyExtraOrigins
+	dst.WebAuthnPolicyAttestationConveyancePreference = src.WebAuthnPolicyAttestationConveyancePreference
+	dst.WebAuthnPolicyAuthenticatorAttachment = src.WebAuthnPolicyAuthenticatorAttachment
+	dst.WebAuthnPolicyAvoidSameAuthenticatorRegister = src.WebAuthnPolicyAvoidSameAuthenticatorRegister
+	dst.WebAuthnPolicyCreateTimeout = src.WebAuthnPolicyCreateTimeout
+	dst.WebAuthnPolicyRequireResidentKey = src.WebAuthnPolicyRequireResidentKey
+	dst.WebAuthnPolicyDiscoverableCredential = src.WebAuthnPolicyDiscoverableCredential
+	dst.WebAuthnPolicyRpEntityName = src.WebAuthnPolicyRpEntityName
+	dst.WebAuthnPolicyRpId = src.WebAuthnPolicyRpId
+	dst.WebAuthnPolicySignatureAlgorithms = src.WebAuthnPolicySignatureAlgorithms
+	dst.WebAuthnPolicyUserVerificationRequirement = src.WebAuthnPolicyUserVerificationRequirement
+	dst.WebAuthnPolicyPasswordlessAcceptableAaguids = src.WebAuthnPolicyPasswordlessAcceptableAaguids
+	dst.WebAuthnPolicyPasswordlessExtraOrigins = src.WebAuthnPolicyPasswordlessExtraOrigins
+	dst.WebAuthnPolicyPasswordlessAttestationConveyancePreference = src.WebAuthnPolicyPasswordlessAttestationConveyancePreference
+	dst.WebAuthnPolicyPasswordlessAuthenticatorAttachment = src.WebAuthnPolicyPasswordlessAuthenticatorAttachment
+	dst.WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister = src.WebAuthnPolicyPasswordlessAvoidSameAuthenticatorRegister
+	dst.WebAuthnPolicyPasswordlessCreateTimeout = src.WebAuthnPolicyPasswordlessCreateTimeout
+	dst.WebAuthnPolicyPasswordlessRequireResidentKey = src.WebAuthnPolicyPasswordlessRequireResidentKey
+	dst.WebAuthnPolicyPasswordlessDiscoverableCredential = src.WebAuthnPolicyPasswordlessDiscoverableCredential
+	dst.WebAuthnPolicyPasswordlessRpEntityName = src.WebAuthnPolicyPasswordlessRpEntityName
+	dst.WebAuthnPolicyPasswordlessRpId = src.WebAuthnPolicyPasswordlessRpId
+	dst.WebAuthnPolicyPasswordlessSignatureAlgorithms = src.WebAuthnPolicyPasswordlessSignatureAlgorithms
+	dst.WebAuthnPolicyPasswordlessUserVerificationRequirement = src.WebAuthnPolicyPasswordlessUserVerificationRequirement
+	dst.WebAuthnPolicyPasswordlessPasskeysEnabled = src.WebAuthnPolicyPasswordlessPasskeysEnabled
+}
+
+// the CIBA and PAR policies are stored as realm attributes, and are reset to their defaults by Keycloak when they're
+// missing from an update, so they are always sent along with the other attributes
+func getRealmCibaAndParPoliciesFromData(data *schema.ResourceData, realm *keycloak.Realm, keycloakVersion *version.Version) error {
+	if v, ok := data.GetOk("ciba_policy"); ok {
+		if minVersion, err := version.NewVersion(minKeycloakCibaVersion); err == nil && keycloakVersion.LessThan(minVersion) {
+			return fmt.Errorf("ciba_policy for realm \"%s\" is not supported by your Keycloak version (requires >= %s)", realm.Realm, minKeycloakCibaVersion)
 		}
+
+		cibaPolicy := v.([]interface{})[0].(map[string]interface{})
+		realm.Attributes[keycloak.RealmAttributeCibaBackchannelTokenDeliveryMode] = cibaPolicy["backchannel_token_delivery_mode"].(string)
+		realm.Attributes[keycloak.RealmAttributeCibaExpiresIn] = strconv.Itoa(cibaPolicy["expires_in"].(int))
+		realm.Attributes[keycloak.RealmAttributeCibaInterval] = strconv.Itoa(cibaPolicy["interval"].(int))
+		realm.Attributes[keycloak.RealmAttributeCibaAuthRequestedUserHint] = cibaPolicy["auth_requested_user_hint"].(string)
 	}
-	data.Set("attributes", attributes)
 
-	// default and optional client scope mappings
-	data.Set("default_default_client_scopes", realm.DefaultDefaultClientScopes)
-	data.Set("default_optional_client_scopes", realm.DefaultOptionalClientScopes)
+	if parRequestUriLifespan := data.Get("par_request_uri_lifespan").(string); parRequestUriLifespan != "" {
+		if minVersion, err := version.NewVersion(minKeycloakParVersion); err == nil && keycloakVersion.LessThan(minVersion) {
+			return fmt.Errorf("par_request_uri_lifespan for realm \"%s\" is not supported by your Keycloak version (requires >= %s)", realm.Realm, minKeycloakParVersion)
+		}
+
+		parRequestUriLifespanSeconds, err := getSecondsFromDurationString(parRequestUriLifespan)
+		if err != nil {
+			return err
+		}
+		if parRequestUriLifespanSeconds <= 0 {
+			return fmt.Errorf("par_request_uri_lifespan for realm \"%s\" must be greater than zero", realm.Realm)
+		}
+		realm.Attributes[keycloak.RealmAttributeParRequestUriLifespan] = strconv.Itoa(parRequestUriLifespanSeconds)
+	}
+
+	return nil
+}
+
+func setRealmCibaAndParPoliciesData(data *schema.ResourceData, realm *keycloak.Realm) {
+	cibaPolicy := realm.GetCibaPolicy()
+	if cibaPolicy != nil {
+		data.Set("ciba_policy", []interface{}{
+			map[string]interface{}{
+				"backchannel_token_delivery_mode": cibaPolicy.BackchannelTokenDeliveryMode,
+				"expires_in":                      cibaPolicy.ExpiresIn,
+				"interval":                        cibaPolicy.Interval,
+				"auth_requested_user_hint":        cibaPolicy.AuthRequestedUserHint,
+			},
+		})
+	} else {
+		data.Set("ciba_policy", nil)
+	}
+
+	if parRequestUriLifespan, ok := realm.GetParRequestUriLifespan(); ok {
+		data.Set("par_request_uri_lifespan", getDurationStringFromSeconds(parRequestUriLifespan))
+	} else {
+		data.Set("par_request_uri_lifespan", "")
+	}
 }
 
 func getBruteForceDetectionSettings(realm *keycloak.Realm, keycloakVersion *version.Version) map[string]interface{} {
@@ -1601,6 +1993,75 @@ func getHeaderSettings(realm *keycloak.Realm) map[string]interface{} {
 	return headersSettings
 }
 
+func getPasswordPolicyRulesFromData(passwordPolicyRules *schema.Set) []keycloak.PasswordPolicyRule {
+	var rules []keycloak.PasswordPolicyRule
+	for _, passwordPolicyRule := range passwordPolicyRules.List() {
+		rule := passwordPolicyRule.(map[string]interface{})
+		rules = append(rules, keycloak.PasswordPolicyRule{
+			Type:  rule["type"].(string),
+			Value: rule["value"].(string),
+		})
+	}
+
+	return rules
+}
+
+func flattenPasswordPolicyRules(passwordPolicy string) []interface{} {
+	rules := make([]interface{}, 0)
+	for _, rule := range keycloak.ParsePasswordPolicy(passwordPolicy) {
+		rules = append(rules, map[string]interface{}{
+			"type":  rule.Type,
+			"value": rule.Value,
+		})
+	}
+
+	return rules
+}
+
+// setRealmPasswordPolicyData keeps the password policy in whichever of password_policy and password_policy_rule is
+// in use, so that switching between them or importing a realm does not cause a diff on the other one.
+func setRealmPasswordPolicyData(data *schema.ResourceData, passwordPolicy string) {
+	if passwordPolicyRules, ok := data.GetOk("password_policy_rule"); ok && passwordPolicyRules.(*schema.Set).Len() != 0 {
+		data.Set("password_policy", "")
+		data.Set("password_policy_rule", flattenPasswordPolicyRules(passwordPolicy))
+		return
+	}
+
+	data.Set("password_policy", passwordPolicy)
+}
+
+// resourceKeycloakRealmPasswordPolicyDiff validates a changed password policy against the password policy providers
+// of the server, so that typos fail at plan time instead of during apply.
+func resourceKeycloakRealmPasswordPolicyDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("password_policy") && !diff.HasChange("password_policy_rule") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("password_policy") || !diff.NewValueKnown("password_policy_rule") {
+		return nil
+	}
+
+	var rules []keycloak.PasswordPolicyRule
+	if passwordPolicyRules, ok := diff.GetOk("password_policy_rule"); ok {
+		rules = getPasswordPolicyRulesFromData(passwordPolicyRules.(*schema.Set))
+	} else {
+		rules = keycloak.ParsePasswordPolicy(diff.Get("password_policy").(string))
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	return serverInfo.ValidatePasswordPolicy(rules)
+}
+
 func resourceKeycloakRealmCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	keycloakVersion, err := keycloakClient.Version(ctx)
@@ -1671,6 +2132,15 @@ func resourceKeycloakRealmUpdate(ctx context.Context, data *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
+	keycloakClient.Mutex.Lock(fmt.Sprintf("keycloakRealm:%s", data.Id()))
+	defer keycloakClient.Mutex.Unlock(fmt.Sprintf("keycloakRealm:%s", data.Id()))
+
+	currentRealm, err := keycloakClient.GetRealm(ctx, data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	preserveUnchangedRealmSettings(data, realm, currentRealm)
+
 	err = keycloakClient.ValidateRealm(ctx, realm)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// keycloak_realm_brute_force_unlock is an action-like resource: creating it clears the brute force lockouts of a user
+// (or of the whole realm), and changing any of its arguments recreates it, which clears the lockouts again.
+func resourceKeycloakRealmBruteForceUnlock() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmBruteForceUnlockCreate,
+		ReadContext:   resourceKeycloakRealmBruteForceUnlockRead,
+		DeleteContext: resourceKeycloakRealmBruteForceUnlockDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The user to unlock. When omitted, lockouts are cleared for every user in the realm.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary values that, when changed, clear the lockouts again.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakRealmBruteForceUnlockCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	if userId == "" {
+		err := keycloakClient.ClearRealmBruteForceStatus(ctx, realmId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		data.SetId(realmId)
+	} else {
+		err := keycloakClient.ClearUserBruteForceStatus(ctx, realmId, userId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		data.SetId(realmId + "/" + userId)
+	}
+
+	return resourceKeycloakRealmBruteForceUnlockRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmBruteForceUnlockRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	// the unlock itself leaves nothing behind on the server, so only make sure the targets still exist
+	if userId == "" {
+		_, err := keycloakClient.GetRealm(ctx, realmId)
+		if err != nil {
+			return handleNotFoundError(ctx, err, data)
+		}
+	} else {
+		_, err := keycloakClient.GetUser(ctx, realmId, userId)
+		if err != nil {
+			return handleNotFoundError(ctx, err, data)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmBruteForceUnlockDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// nothing to undo, lockouts that were cleared stay cleared
+	return nil
+}
@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmBruteForceUnlock_user(t *testing.T) {
+	t.Parallel()
+
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmBruteForceUnlock_user(username, "one"),
+				Check:  testAccCheckKeycloakUserIsNotLockedOut("keycloak_realm_brute_force_unlock.unlock"),
+			},
+			{
+				Config: testKeycloakRealmBruteForceUnlock_user(username, "two"),
+				Check:  testAccCheckKeycloakUserIsNotLockedOut("keycloak_realm_brute_force_unlock.unlock"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmBruteForceUnlock_realm(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmBruteForceUnlock_realm(),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm_brute_force_unlock.unlock", "id", testAccRealm.Realm),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserIsNotLockedOut(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.Attributes["user_id"]
+
+		status, err := keycloakClient.GetUserBruteForceStatus(testCtx, realmId, userId)
+		if err != nil {
+			return err
+		}
+
+		if status.Disabled || status.NumFailures != 0 {
+			return fmt.Errorf("expected user %s to not be locked out, got %d failures (disabled: %t)", userId, status.NumFailures, status.Disabled)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmBruteForceUnlock_user(username, trigger string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_realm_brute_force_unlock" "unlock" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+
+	triggers = {
+		incident = "%s"
+	}
+}
+	`, testAccRealm.Realm, username, trigger)
+}
+
+func testKeycloakRealmBruteForceUnlock_realm() string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_brute_force_unlock" "unlock" {
+	realm_id = data.keycloak_realm.realm.id
+}
+	`, testAccRealm.Realm)
+}
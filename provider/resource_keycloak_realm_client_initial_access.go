@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmClientInitialAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmClientInitialAccessCreate,
+		ReadContext:   resourceKeycloakRealmClientInitialAccessRead,
+		DeleteContext: resourceKeycloakRealmClientInitialAccessDelete,
+		// the token itself is only returned when it is created, so this resource cannot be imported
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Number of seconds the token is valid for. 0 means the token never expires.",
+			},
+			"client_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of clients that can be registered with the token.",
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"remaining_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the token expires in RFC 3339 format, empty if the token never expires.",
+			},
+		},
+	}
+}
+
+func setRealmClientInitialAccessData(data *schema.ResourceData, initialAccess *keycloak.RealmClientInitialAccess) {
+	data.SetId(initialAccess.Id)
+
+	data.Set("realm_id", initialAccess.RealmId)
+	data.Set("expiration", initialAccess.Expiration)
+	data.Set("client_count", initialAccess.Count)
+	data.Set("remaining_count", initialAccess.RemainingCount)
+
+	if expiresAt := initialAccess.ExpiresAt(); expiresAt.IsZero() {
+		data.Set("expires_at", "")
+	} else {
+		data.Set("expires_at", expiresAt.UTC().Format(time.RFC3339))
+	}
+}
+
+func resourceKeycloakRealmClientInitialAccessCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	initialAccess := &keycloak.RealmClientInitialAccess{
+		RealmId:    data.Get("realm_id").(string),
+		Expiration: data.Get("expiration").(int),
+		Count:      data.Get("client_count").(int),
+	}
+
+	err := keycloakClient.NewRealmClientInitialAccess(ctx, initialAccess)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.Set("token", initialAccess.Token)
+	setRealmClientInitialAccessData(data, initialAccess)
+
+	return resourceKeycloakRealmClientInitialAccessRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmClientInitialAccessRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	initialAccess, err := keycloakClient.GetRealmClientInitialAccess(ctx, realmId, data.Id())
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	// Keycloak only removes expired tokens periodically, drop the token from state as soon as it expired so that it is recreated
+	if initialAccess.IsExpired() {
+		tflog.Warn(ctx, "Removing client initial access token from state as it has expired", map[string]interface{}{
+			"id": data.Id(),
+		})
+		data.SetId("")
+
+		return nil
+	}
+
+	setRealmClientInitialAccessData(data, initialAccess)
+
+	return nil
+}
+
+func resourceKeycloakRealmClientInitialAccessDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	err := keycloakClient.DeleteRealmClientInitialAccess(ctx, data.Get("realm_id").(string), data.Id())
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
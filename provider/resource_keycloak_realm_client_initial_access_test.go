@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func TestAccKeycloakRealmClientInitialAccess_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmClientInitialAccessDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientInitialAccess_basic(3600, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientInitialAccessExists("keycloak_realm_client_initial_access.token"),
+					resource.TestCheckResourceAttrSet("keycloak_realm_client_initial_access.token", "token"),
+					resource.TestCheckResourceAttrSet("keycloak_realm_client_initial_access.token", "expires_at"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_initial_access.token", "remaining_count", "5"),
+				),
+			},
+			{
+				Config: testKeycloakRealmClientInitialAccess_basic(0, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientInitialAccessExists("keycloak_realm_client_initial_access.token"),
+					resource.TestCheckResourceAttr("keycloak_realm_client_initial_access.token", "expires_at", ""),
+					resource.TestCheckResourceAttr("keycloak_realm_client_initial_access.token", "remaining_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientInitialAccess_createAfterManualDestroy(t *testing.T) {
+	t.Parallel()
+
+	var initialAccess = &keycloak.RealmClientInitialAccess{}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmClientInitialAccessDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientInitialAccess_basic(3600, 1),
+				Check:  testAccCheckKeycloakRealmClientInitialAccessFetch("keycloak_realm_client_initial_access.token", initialAccess),
+			},
+			{
+				PreConfig: func() {
+					err := keycloakClient.DeleteRealmClientInitialAccess(testCtx, initialAccess.RealmId, initialAccess.Id)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmClientInitialAccess_basic(3600, 1),
+				Check:  testAccCheckKeycloakRealmClientInitialAccessExists("keycloak_realm_client_initial_access.token"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmClientInitialAccessExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := getRealmClientInitialAccessFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmClientInitialAccessFetch(resourceName string, initialAccess *keycloak.RealmClientInitialAccess) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedInitialAccess, err := getRealmClientInitialAccessFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		initialAccess.Id = fetchedInitialAccess.Id
+		initialAccess.RealmId = fetchedInitialAccess.RealmId
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmClientInitialAccessDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_client_initial_access" {
+				continue
+			}
+
+			_, err := keycloakClient.GetRealmClientInitialAccess(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("client initial access token %s still exists", rs.Primary.ID)
+			}
+			if !keycloak.ErrorIs404(err) {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func getRealmClientInitialAccessFromState(s *terraform.State, resourceName string) (*keycloak.RealmClientInitialAccess, error) {
+	rs, ok := s.RootModule().Resources[resourceName]
+	if !ok {
+		return nil, fmt.Errorf("resource not found: %s", resourceName)
+	}
+
+	initialAccess, err := keycloakClient.GetRealmClientInitialAccess(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.ID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting client initial access token %s: %s", rs.Primary.ID, err)
+	}
+
+	return initialAccess, nil
+}
+
+func testKeycloakRealmClientInitialAccess_basic(expiration, count int) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_client_initial_access" "token" {
+	realm_id     = data.keycloak_realm.realm.id
+	expiration   = %d
+	client_count = %d
+}
+	`, testAccRealm.Realm, expiration, count)
+}
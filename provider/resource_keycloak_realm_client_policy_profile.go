@@ -17,6 +17,7 @@ func resourceKeycloakRealmClientPolicyProfile() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmClientPolicyProfileRead,
 		DeleteContext: resourceKeycloakRealmClientPolicyProfileDelete,
 		UpdateContext: resourceKeycloakRealmClientPolicyProfileUpdate,
+		CustomizeDiff: resourceKeycloakRealmClientPolicyProfileDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -51,6 +52,51 @@ func resourceKeycloakRealmClientPolicyProfile() *schema.Resource {
 	}
 }
 
+func resourceKeycloakRealmClientPolicyProfileDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return validateRealmClientPolicyComponents(ctx, diff, meta, "executor", (*keycloak.ServerInfo).ValidateClientPolicyExecutor)
+}
+
+// validateRealmClientPolicyComponents checks every executor or condition block against the client policy metadata
+// published in the server info, so that unknown providers and misspelled configuration keys fail at plan time.
+func validateRealmClientPolicyComponents(ctx context.Context, diff *schema.ResourceDiff, meta interface{}, block string, validate func(*keycloak.ServerInfo, string, map[string]string) error) error {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	components := diff.Get(block).([]interface{})
+	if len(components) == 0 {
+		return nil
+	}
+
+	serverInfo, err := keycloakClient.GetServerInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, component := range components {
+		componentMap := component.(map[string]interface{})
+		if !diff.NewValueKnown(fmt.Sprintf("%s.%d.name", block, i)) {
+			continue
+		}
+
+		configuration := make(map[string]string)
+		if v, ok := componentMap["configuration"].(map[string]interface{}); ok {
+			for key, value := range v {
+				// values only known after apply are validated on the next plan
+				if !diff.NewValueKnown(fmt.Sprintf("%s.%d.configuration.%s", block, i, key)) {
+					continue
+				}
+				configuration[key] = value.(string)
+			}
+		}
+
+		err = validate(serverInfo, componentMap["name"].(string), configuration)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceKeycloakRealmClientPolicyProfileUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	profile := mapFromDataToRealmClientPolicyProfile(data)
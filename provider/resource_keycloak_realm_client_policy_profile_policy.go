@@ -17,6 +17,7 @@ func resourceKeycloakRealmClientPolicyProfilePolicy() *schema.Resource {
 		ReadContext:   resourceKeycloakRealmClientPolicyProfilePolicyRead,
 		DeleteContext: resourceKeycloakRealmClientPolicyProfilePolicyDelete,
 		UpdateContext: resourceKeycloakRealmClientPolicyProfilePolicyUpdate,
+		CustomizeDiff: resourceKeycloakRealmClientPolicyProfilePolicyDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -63,6 +64,24 @@ func resourceKeycloakRealmClientPolicyProfilePolicy() *schema.Resource {
 	}
 }
 
+// is_negative_logic was accepted before conditions were validated, but Keycloak only knows the hyphenated key
+const deprecatedRealmClientPolicyConditionNegativeLogicKey = "is_negative_logic"
+const realmClientPolicyConditionNegativeLogicKey = "is-negative-logic"
+
+func resourceKeycloakRealmClientPolicyProfilePolicyDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return validateRealmClientPolicyComponents(ctx, diff, meta, "condition", func(serverInfo *keycloak.ServerInfo, name string, configuration map[string]string) error {
+		if value, ok := configuration[deprecatedRealmClientPolicyConditionNegativeLogicKey]; ok {
+			if _, ok := configuration[realmClientPolicyConditionNegativeLogicKey]; ok {
+				return fmt.Errorf("validation error: client policy condition \"%s\" sets both %s and %s, remove the deprecated %s", name, deprecatedRealmClientPolicyConditionNegativeLogicKey, realmClientPolicyConditionNegativeLogicKey, deprecatedRealmClientPolicyConditionNegativeLogicKey)
+			}
+			delete(configuration, deprecatedRealmClientPolicyConditionNegativeLogicKey)
+			configuration[realmClientPolicyConditionNegativeLogicKey] = value
+		}
+
+		return serverInfo.ValidateClientPolicyCondition(name, configuration)
+	})
+}
+
 func resourceKeycloakRealmClientPolicyProfilePolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 	policy := mapFromDataToRealmClientPolicyProfilePolicy(data)
@@ -184,6 +203,9 @@ func mapFromDataToRealmClientPolicyProfilePolicy(data *schema.ResourceData) *key
 					configurations[key] = t
 					continue
 				}
+				if key == deprecatedRealmClientPolicyConditionNegativeLogicKey {
+					key = realmClientPolicyConditionNegativeLogicKey
+				}
 				configurations[key] = value
 			}
 			cond.Configuration = configurations
@@ -214,7 +236,7 @@ func mapFromRealmClientPolicyProfilePolicyToData(data *schema.ResourceData, poli
 	data.Set("profiles", policy.Profiles)
 
 	conditions := make([]interface{}, 0)
-	for _, cond := range policy.Conditions {
+	for i, cond := range policy.Conditions {
 
 		conditionMap := map[string]interface{}{
 			"name": cond.Name,
@@ -232,6 +254,14 @@ func mapFromRealmClientPolicyProfilePolicyToData(data *schema.ResourceData, poli
 					configurations[k] = v
 				}
 			}
+
+			// keep the deprecated spelling of the key if that is what the configuration uses
+			if v, ok := configurations[realmClientPolicyConditionNegativeLogicKey]; ok {
+				if _, ok := data.GetOk(fmt.Sprintf("condition.%d.configuration.%s", i, deprecatedRealmClientPolicyConditionNegativeLogicKey)); ok {
+					delete(configurations, realmClientPolicyConditionNegativeLogicKey)
+					configurations[deprecatedRealmClientPolicyConditionNegativeLogicKey] = v
+				}
+			}
 			conditionMap["configuration"] = configurations
 		}
 		conditions = append(conditions, conditionMap)
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -124,6 +125,107 @@ func TestAccKeycloakRealmClientPolicyProfile_basicWithPolicyAndJSON(t *testing.T
 	})
 }
 
+func TestAccKeycloakRealmClientPolicyProfile_deprecatedNegativeLogicKey(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	conditionName := "client-updater-context"
+	deprecatedConfiguration := map[string]interface{}{
+		"is_negative_logic":    true,
+		"update-client-source": []string{"ByInitialAccessToken"},
+	}
+	configuration := map[string]interface{}{
+		"is-negative-logic":    true,
+		"update-client-source": []string{"ByInitialAccessToken"},
+	}
+	bothConfiguration := map[string]interface{}{
+		"is_negative_logic":    true,
+		"is-negative-logic":    true,
+		"update-client-source": []string{"ByInitialAccessToken"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmClientPolicyProfile_basicWithPolicy(realmName, "test-profile", "description", "test-policy", "description", conditionName, testKeycloakRealmClientPolicyProfile_mapConfig(deprecatedConfiguration)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicyProfilePolicyMatches(realmName, "test-policy", conditionName, configuration),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile_policy.policy", "condition.0.configuration.is_negative_logic", "true"),
+				),
+			},
+			{
+				Config: testKeycloakRealmClientPolicyProfile_basicWithPolicy(realmName, "test-profile", "description", "test-policy", "description", conditionName, testKeycloakRealmClientPolicyProfile_mapConfig(configuration)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmClientPolicyProfilePolicyMatches(realmName, "test-policy", conditionName, configuration),
+					resource.TestCheckResourceAttr("keycloak_realm_client_policy_profile_policy.policy", "condition.0.configuration.is-negative-logic", "true"),
+					resource.TestCheckNoResourceAttr("keycloak_realm_client_policy_profile_policy.policy", "condition.0.configuration.is_negative_logic"),
+				),
+			},
+			{
+				Config:      testKeycloakRealmClientPolicyProfile_basicWithPolicy(realmName, "test-profile", "description", "test-policy", "description", conditionName, testKeycloakRealmClientPolicyProfile_mapConfig(bothConfiguration)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`sets both is_negative_logic and is-negative-logic`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientPolicyProfile_unknownExecutor(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmClientPolicyProfile_basicWithExecutor(realmName, "test-profile", "description", "pkce-enforcers", "{}"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`client policy executor "pkce-enforcers" does not exist on the server`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientPolicyProfile_invalidExecutorConfiguration(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmClientPolicyProfile_basicWithExecutor(realmName, "test-profile", "description", "pkce-enforcer", `{ auto_configure = "true" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`configuration key "auto_configure" is not supported by client policy executor "pkce-enforcer"`),
+			},
+			{
+				Config:      testKeycloakRealmClientPolicyProfile_basicWithExecutor(realmName, "test-profile", "description", "pkce-enforcer", `{ auto-configure = "maybe" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`configuration key "auto-configure" of client policy executor "pkce-enforcer" must be a boolean`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmClientPolicyProfile_invalidConditionConfiguration(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	configuration := map[string]interface{}{
+		"update-client-source": []string{"ByCarrierPigeon"},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmClientPolicyProfile_basicWithPolicy(realmName, "test-profile", "description", "test-policy", "description", "client-updater-context", testKeycloakRealmClientPolicyProfile_mapConfig(configuration)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`configuration key "update-client-source" of client policy condition "client-updater-context" must only contain values of`),
+			},
+		},
+	})
+}
+
 func testKeycloakRealmClientPolicyProfile_mapConfig(configuration map[string]interface{}) string {
 	var s string = "{"
 	for k, v := range configuration {
@@ -295,7 +397,10 @@ func testAccCheckKeycloakRealmClientPolicyProfilePolicyMatches(realm string, pol
 		}
 
 		for k, got := range policy.Conditions[0].Configuration {
-			want := configuration[k]
+			want, ok := configuration[k]
+			if !ok && k == realmClientPolicyConditionNegativeLogicKey {
+				want = configuration[deprecatedRealmClientPolicyConditionNegativeLogicKey]
+			}
 
 			if !equalsIgnoreType(got, want) {
 				return fmt.Errorf("Client policy profile policy condition configuration does not match: want %v, got %v", want, got)
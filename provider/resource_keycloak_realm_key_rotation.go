@@ -0,0 +1,528 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+var (
+	keycloakRealmKeyRotationProviderIds = []string{"rsa-generated", "rsa-enc-generated", "ecdsa-generated", "eddsa-generated", "hmac-generated", "aes-generated"}
+)
+
+// keycloak_realm_key_rotation manages a chain of generated key providers. The newest provider is the active one, every
+// provider it replaced is kept passive for the grace period, so tokens it signed can still be verified, then disabled
+// and finally deleted. Time based transitions are detected at plan time, so they happen on the first apply after they are due.
+func resourceKeycloakRealmKeyRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmKeyRotationCreate,
+		ReadContext:   resourceKeycloakRealmKeyRotationRead,
+		UpdateContext: resourceKeycloakRealmKeyRotationUpdate,
+		DeleteContext: resourceKeycloakRealmKeyRotationDelete,
+		CustomizeDiff: resourceKeycloakRealmKeyRotationDiff,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"provider_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(keycloakRealmKeyRotationProviderIds, false),
+				Description:  "The generated key provider to rotate, for example rsa-generated.",
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Prefix of the display name of the key providers, the creation time is appended to it.",
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Provider specific settings of the generated keys, for example keySize or algorithm. Changing them rotates the key.",
+			},
+			"priority": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "Priority of the first key provider. Every rotation uses a priority higher than the one of the replaced provider.",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Arbitrary value that, when changed, rotates the key.",
+			},
+			"rotation_period": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateKeyRotationDuration,
+				DiffSuppressFunc: suppressDurationStringDiff,
+				Description:      "When set, the key is rotated on the first apply after it is older than this duration.",
+			},
+			"grace_period": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "168h",
+				ValidateDiagFunc: validateKeyRotationDuration,
+				DiffSuppressFunc: suppressDurationStringDiff,
+				Description:      "How long a replaced key provider is kept passive, so that tokens it signed can still be verified.",
+			},
+			"disabled_period": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "0s",
+				ValidateDiagFunc: validateKeyRotationDuration,
+				DiffSuppressFunc: suppressDurationStringDiff,
+				Description:      "How long a replaced key provider is kept disabled after the grace period before it is deleted.",
+			},
+			"key_provider_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_provider_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_provider_priority": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rotated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"retired_key_providers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"retired_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"disabled_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type retiredKeyProvider struct {
+	Id         string
+	Name       string
+	RetiredAt  time.Time
+	DisabledAt time.Time
+}
+
+func validateKeyRotationDuration(v interface{}, path cty.Path) diag.Diagnostics {
+	_, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Invalid duration",
+			Detail:        err.Error(),
+			AttributePath: path,
+		}}
+	}
+
+	return nil
+}
+
+// keyRotationDuration returns the duration of an already validated duration attribute, an empty string means zero
+func keyRotationDuration(s string) time.Duration {
+	duration, _ := time.ParseDuration(s)
+	return duration
+}
+
+func parseKeyRotationTime(s string) time.Time {
+	t, _ := time.Parse(time.RFC3339, s)
+	return t
+}
+
+func formatKeyRotationTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func getRetiredKeyProvidersFromData(retiredKeyProvidersData []interface{}) []*retiredKeyProvider {
+	var retiredKeyProviders []*retiredKeyProvider
+	for _, d := range retiredKeyProvidersData {
+		retiredKeyProviderData := d.(map[string]interface{})
+		retiredKeyProviders = append(retiredKeyProviders, &retiredKeyProvider{
+			Id:         retiredKeyProviderData["id"].(string),
+			Name:       retiredKeyProviderData["name"].(string),
+			RetiredAt:  parseKeyRotationTime(retiredKeyProviderData["retired_at"].(string)),
+			DisabledAt: parseKeyRotationTime(retiredKeyProviderData["disabled_at"].(string)),
+		})
+	}
+
+	return retiredKeyProviders
+}
+
+func setRetiredKeyProvidersData(data *schema.ResourceData, retiredKeyProviders []*retiredKeyProvider) {
+	retiredKeyProvidersData := make([]interface{}, 0, len(retiredKeyProviders))
+	for _, retiredKeyProvider := range retiredKeyProviders {
+		retiredKeyProvidersData = append(retiredKeyProvidersData, map[string]interface{}{
+			"id":          retiredKeyProvider.Id,
+			"name":        retiredKeyProvider.Name,
+			"retired_at":  formatKeyRotationTime(retiredKeyProvider.RetiredAt),
+			"disabled_at": formatKeyRotationTime(retiredKeyProvider.DisabledAt),
+		})
+	}
+
+	data.Set("retired_key_providers", retiredKeyProvidersData)
+}
+
+func keyRotationIsDue(rotationPeriod, rotatedAt string, now time.Time) bool {
+	if rotationPeriod == "" || rotatedAt == "" {
+		return false
+	}
+
+	return !now.Before(parseKeyRotationTime(rotatedAt).Add(keyRotationDuration(rotationPeriod)))
+}
+
+func retiredKeyProviderShouldBeDisabled(retiredKeyProvider *retiredKeyProvider, gracePeriod time.Duration, now time.Time) bool {
+	return retiredKeyProvider.DisabledAt.IsZero() && !now.Before(retiredKeyProvider.RetiredAt.Add(gracePeriod))
+}
+
+func retiredKeyProviderShouldBeDeleted(retiredKeyProvider *retiredKeyProvider, disabledPeriod time.Duration, now time.Time) bool {
+	return !retiredKeyProvider.DisabledAt.IsZero() && !now.Before(retiredKeyProvider.DisabledAt.Add(disabledPeriod))
+}
+
+func resourceKeycloakRealmKeyRotationDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	now := time.Now()
+
+	// the key provider id is empty when the current provider was deleted outside of Terraform, see Read
+	if d.HasChange("rotation_trigger") || d.HasChange("config") || d.Get("key_provider_id").(string) == "" || keyRotationIsDue(d.Get("rotation_period").(string), d.Get("rotated_at").(string), now) {
+		for _, key := range []string{"key_provider_id", "key_provider_name", "key_provider_priority", "rotated_at", "retired_key_providers"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	gracePeriod := keyRotationDuration(d.Get("grace_period").(string))
+	disabledPeriod := keyRotationDuration(d.Get("disabled_period").(string))
+
+	for _, retiredKeyProvider := range getRetiredKeyProvidersFromData(d.Get("retired_key_providers").([]interface{})) {
+		// a provider that is disabled now could also be deleted within the same apply, both are covered by a single change
+		if retiredKeyProviderShouldBeDisabled(retiredKeyProvider, gracePeriod, now) || retiredKeyProviderShouldBeDeleted(retiredKeyProvider, disabledPeriod, now) {
+			return d.SetNewComputed("retired_key_providers")
+		}
+	}
+
+	return nil
+}
+
+// verifyRealmKeyProviderStatus uses the keys endpoint of the realm to make sure Keycloak actually uses the keys of a
+// provider the way the rotation expects, before the rotation moves on to the next step
+func verifyRealmKeyProviderStatus(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, id string, expectedStatus ...string) error {
+	keys, err := keycloakClient.GetRealmKeysForProvider(ctx, realmId, id)
+	if err != nil {
+		return err
+	}
+
+	if len(keys) == 0 {
+		return fmt.Errorf("expected key provider %s to have keys with status %s, but it has no keys", id, strings.Join(expectedStatus, " or "))
+	}
+
+	for _, key := range keys {
+		status := StringValue(key.Status)
+		if !stringSliceContains(expectedStatus, status) {
+			return fmt.Errorf("expected the keys of key provider %s to have status %s, but key %s has status %s", id, strings.Join(expectedStatus, " or "), StringValue(key.Kid), status)
+		}
+	}
+
+	return nil
+}
+
+func newRealmKeyRotationKeyProvider(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, priority int, now time.Time) (*keycloak.RealmGeneratedKeyProvider, error) {
+	config := map[string]string{}
+	for key, value := range data.Get("config").(map[string]interface{}) {
+		config[key] = value.(string)
+	}
+
+	keyProvider := &keycloak.RealmGeneratedKeyProvider{
+		Name:       fmt.Sprintf("%s-%s", data.Get("name_prefix").(string), now.UTC().Format("20060102150405")),
+		RealmId:    data.Get("realm_id").(string),
+		ProviderId: data.Get("provider_id").(string),
+		Active:     true,
+		Enabled:    true,
+		Priority:   priority,
+		Config:     config,
+	}
+
+	err := keycloakClient.NewRealmGeneratedKeyProvider(ctx, keyProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	err = verifyRealmKeyProviderStatus(ctx, keycloakClient, keyProvider.RealmId, keyProvider.Id, "ACTIVE")
+	if err != nil {
+		// the provider isn't tracked by the state yet, so it would be left behind
+		deleteErr := keycloakClient.DeleteRealmGeneratedKeyProvider(ctx, keyProvider.RealmId, keyProvider.Id)
+		if deleteErr != nil {
+			return nil, fmt.Errorf("%w, deleting key provider %s failed as well: %w", err, keyProvider.Id, deleteErr)
+		}
+
+		return nil, err
+	}
+
+	return keyProvider, nil
+}
+
+func setRealmKeyRotationKeyProviderData(data *schema.ResourceData, keyProvider *keycloak.RealmGeneratedKeyProvider) {
+	data.Set("key_provider_id", keyProvider.Id)
+	data.Set("key_provider_name", keyProvider.Name)
+	data.Set("key_provider_priority", keyProvider.Priority)
+}
+
+// retireRealmKeyProviders moves the replaced key providers through the passive, disabled and deleted states and returns
+// the providers that still exist afterwards
+func retireRealmKeyProviders(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData, retiredKeyProviders []*retiredKeyProvider, now time.Time) ([]*retiredKeyProvider, error) {
+	realmId := data.Get("realm_id").(string)
+	gracePeriod := keyRotationDuration(data.Get("grace_period").(string))
+	disabledPeriod := keyRotationDuration(data.Get("disabled_period").(string))
+
+	var remainingKeyProviders []*retiredKeyProvider
+	for _, retiredKeyProvider := range retiredKeyProviders {
+		if retiredKeyProviderShouldBeDisabled(retiredKeyProvider, gracePeriod, now) {
+			keyProvider, err := keycloakClient.GetRealmGeneratedKeyProvider(ctx, realmId, retiredKeyProvider.Id)
+			if err != nil {
+				if keycloak.ErrorIs404(err) {
+					continue
+				}
+				return nil, err
+			}
+
+			keyProvider.Active = false
+			keyProvider.Enabled = false
+
+			err = keycloakClient.UpdateRealmGeneratedKeyProvider(ctx, keyProvider)
+			if err != nil {
+				return nil, err
+			}
+
+			err = verifyRealmKeyProviderStatus(ctx, keycloakClient, realmId, keyProvider.Id, "DISABLED")
+			if err != nil {
+				return nil, err
+			}
+
+			retiredKeyProvider.DisabledAt = now
+		}
+
+		if retiredKeyProviderShouldBeDeleted(retiredKeyProvider, disabledPeriod, now) {
+			err := keycloakClient.DeleteRealmGeneratedKeyProvider(ctx, realmId, retiredKeyProvider.Id)
+			if err != nil && !keycloak.ErrorIs404(err) {
+				return nil, err
+			}
+
+			continue
+		}
+
+		remainingKeyProviders = append(remainingKeyProviders, retiredKeyProvider)
+	}
+
+	return remainingKeyProviders, nil
+}
+
+func resourceKeycloakRealmKeyRotationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	now := time.Now()
+
+	keycloakClient.Mutex.Lock(fmt.Sprintf("keycloakRealmKeys:%s", realmId))
+	defer keycloakClient.Mutex.Unlock(fmt.Sprintf("keycloakRealmKeys:%s", realmId))
+
+	keyProvider, err := newRealmKeyRotationKeyProvider(ctx, keycloakClient, data, data.Get("priority").(int), now)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, data.Get("name_prefix").(string)))
+	setRealmKeyRotationKeyProviderData(data, keyProvider)
+	data.Set("rotated_at", formatKeyRotationTime(now))
+	setRetiredKeyProvidersData(data, nil)
+
+	return resourceKeycloakRealmKeyRotationRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmKeyRotationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	// the id is empty once the current provider was found to be deleted, until the next apply replaces it
+	var keyProvider *keycloak.RealmGeneratedKeyProvider
+	var err error
+	if keyProviderId := data.Get("key_provider_id").(string); keyProviderId != "" {
+		keyProvider, err = keycloakClient.GetRealmGeneratedKeyProvider(ctx, realmId, keyProviderId)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	var retiredKeyProviders []*retiredKeyProvider
+	for _, retiredKeyProvider := range getRetiredKeyProvidersFromData(data.Get("retired_key_providers").([]interface{})) {
+		_, err := keycloakClient.GetRealmGeneratedKeyProvider(ctx, realmId, retiredKeyProvider.Id)
+		if err != nil {
+			if keycloak.ErrorIs404(err) {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+
+		retiredKeyProviders = append(retiredKeyProviders, retiredKeyProvider)
+	}
+
+	if keyProvider == nil {
+		// the retired providers would be left behind if the resource was removed, so the next apply creates a new
+		// provider instead
+		if len(retiredKeyProviders) == 0 && err != nil {
+			return handleNotFoundError(ctx, err, data)
+		}
+
+		data.Set("key_provider_id", "")
+		data.Set("key_provider_name", "")
+		data.Set("key_provider_priority", 0)
+	} else {
+		setRealmKeyRotationKeyProviderData(data, keyProvider)
+	}
+
+	setRetiredKeyProvidersData(data, retiredKeyProviders)
+
+	return nil
+}
+
+func resourceKeycloakRealmKeyRotationUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	now := time.Now()
+
+	keycloakClient.Mutex.Lock(fmt.Sprintf("keycloakRealmKeys:%s", realmId))
+	defer keycloakClient.Mutex.Unlock(fmt.Sprintf("keycloakRealmKeys:%s", realmId))
+
+	// computed attributes are unknown in the planned state, their previous values are needed here
+	oldKeyProviderId, _ := data.GetChange("key_provider_id")
+	oldRotatedAt, _ := data.GetChange("rotated_at")
+	oldRetiredKeyProviders, _ := data.GetChange("retired_key_providers")
+
+	retiredKeyProviders := getRetiredKeyProvidersFromData(oldRetiredKeyProviders.([]interface{}))
+
+	if data.HasChange("rotation_trigger") || data.HasChange("config") || oldKeyProviderId.(string) == "" || keyRotationIsDue(data.Get("rotation_period").(string), oldRotatedAt.(string), now) {
+		priority := data.Get("priority").(int)
+
+		var currentKeyProvider *keycloak.RealmGeneratedKeyProvider
+		if oldKeyProviderId.(string) != "" {
+			var err error
+			currentKeyProvider, err = keycloakClient.GetRealmGeneratedKeyProvider(ctx, realmId, oldKeyProviderId.(string))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			priority = max(priority, currentKeyProvider.Priority+1)
+		}
+
+		keyProvider, err := newRealmKeyRotationKeyProvider(ctx, keycloakClient, data, priority, now)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		// the state tracks both providers before the replaced one is changed, so neither is lost if that fails
+		setRealmKeyRotationKeyProviderData(data, keyProvider)
+		data.Set("rotated_at", formatKeyRotationTime(now))
+
+		if currentKeyProvider != nil {
+			retiredKeyProviders = append(retiredKeyProviders, &retiredKeyProvider{
+				Id:        currentKeyProvider.Id,
+				Name:      currentKeyProvider.Name,
+				RetiredAt: now,
+			})
+			setRetiredKeyProvidersData(data, retiredKeyProviders)
+
+			// the replaced provider stays enabled but passive, its keys are only used to verify tokens from now on
+			currentKeyProvider.Active = false
+
+			err = keycloakClient.UpdateRealmGeneratedKeyProvider(ctx, currentKeyProvider)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			err = verifyRealmKeyProviderStatus(ctx, keycloakClient, realmId, currentKeyProvider.Id, "PASSIVE")
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	} else {
+		data.Set("key_provider_id", oldKeyProviderId)
+		data.Set("rotated_at", oldRotatedAt)
+	}
+
+	retiredKeyProviders, err := retireRealmKeyProviders(ctx, keycloakClient, data, retiredKeyProviders, now)
+	setRetiredKeyProvidersData(data, retiredKeyProviders)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmKeyRotationRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmKeyRotationDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	keycloakClient.Mutex.Lock(fmt.Sprintf("keycloakRealmKeys:%s", realmId))
+	defer keycloakClient.Mutex.Unlock(fmt.Sprintf("keycloakRealmKeys:%s", realmId))
+
+	var keyProviderIds []string
+	if keyProviderId := data.Get("key_provider_id").(string); keyProviderId != "" {
+		keyProviderIds = append(keyProviderIds, keyProviderId)
+	}
+	for _, retiredKeyProvider := range getRetiredKeyProvidersFromData(data.Get("retired_key_providers").([]interface{})) {
+		keyProviderIds = append(keyProviderIds, retiredKeyProvider.Id)
+	}
+
+	for _, id := range keyProviderIds {
+		err := keycloakClient.DeleteRealmGeneratedKeyProvider(ctx, realmId, id)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmKeyRotation_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmKeyRotationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeyRotation_basic(realmName, "1", "168h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmKeyRotationKeyStatus("keycloak_realm_key_rotation.rotation", "ACTIVE"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key_provider_priority", "100"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "retired_key_providers.#", "0"),
+				),
+			},
+			{
+				Config: testKeycloakRealmKeyRotation_basic(realmName, "2", "168h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmKeyRotationKeyStatus("keycloak_realm_key_rotation.rotation", "ACTIVE"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "key_provider_priority", "101"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "retired_key_providers.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "retired_key_providers.0.disabled_at", ""),
+				),
+			},
+			{
+				// without a grace period the retired provider is disabled and, without a disabled period, deleted right away
+				Config: testKeycloakRealmKeyRotation_basic(realmName, "2", "0s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmKeyRotationKeyStatus("keycloak_realm_key_rotation.rotation", "ACTIVE"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "retired_key_providers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmKeyRotation_currentKeyProviderDeleted(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	keyProviderId := ""
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmKeyRotationDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmKeyRotation_basic(realmName, "1", "168h"),
+			},
+			{
+				Config: testKeycloakRealmKeyRotation_basic(realmName, "2", "168h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "retired_key_providers.#", "1"),
+					testAccCheckKeycloakRealmKeyRotationFetchKeyProviderId("keycloak_realm_key_rotation.rotation", &keyProviderId),
+				),
+			},
+			{
+				// the retired provider stays in the state, and a new provider replaces the deleted one
+				PreConfig: func() {
+					err := keycloakClient.DeleteRealmGeneratedKeyProvider(testCtx, realmName, keyProviderId)
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmKeyRotation_basic(realmName, "2", "168h"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmKeyRotationKeyStatus("keycloak_realm_key_rotation.rotation", "ACTIVE"),
+					resource.TestCheckResourceAttr("keycloak_realm_key_rotation.rotation", "retired_key_providers.#", "1"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["keycloak_realm_key_rotation.rotation"].Primary.Attributes["key_provider_id"]; id == keyProviderId {
+							return fmt.Errorf("expected the deleted key provider %s to be replaced", keyProviderId)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmKeyRotationFetchKeyProviderId(resourceName string, keyProviderId *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		*keyProviderId = rs.Primary.Attributes["key_provider_id"]
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmKeyRotationKeyStatus(resourceName, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		keyProviderId := rs.Primary.Attributes["key_provider_id"]
+
+		return verifyRealmKeyProviderStatus(testCtx, keycloakClient, realmId, keyProviderId, status)
+	}
+}
+
+func testAccCheckKeycloakRealmKeyRotationDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_key_rotation" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			keyProviderId := rs.Primary.Attributes["key_provider_id"]
+
+			keyProvider, _ := keycloakClient.GetRealmGeneratedKeyProvider(testCtx, realmId, keyProviderId)
+			if keyProvider != nil {
+				return fmt.Errorf("key provider with id %s still exists", keyProviderId)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmKeyRotation_basic(realm, trigger, gracePeriod string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_key_rotation" "rotation" {
+	realm_id         = keycloak_realm.realm.id
+	provider_id      = "rsa-generated"
+	name_prefix      = "rsa-rotated"
+	rotation_trigger = "%s"
+	grace_period     = "%s"
+
+	config = {
+		keySize   = "2048"
+		algorithm = "RS256"
+	}
+}
+	`, realm, trigger, gracePeriod)
+}
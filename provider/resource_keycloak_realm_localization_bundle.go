@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// keycloak_realm_localization_bundle manages the localization texts of several locales from Java .properties message
+// bundles. The bundles are compared key by key with the texts of the realm, only changed keys are sent to Keycloak.
+func resourceKeycloakRealmLocalizationBundle() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmLocalizationBundleUpdate,
+		ReadContext:   resourceKeycloakRealmLocalizationBundleRead,
+		UpdateContext: resourceKeycloakRealmLocalizationBundleUpdate,
+		DeleteContext: resourceKeycloakRealmLocalizationBundleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmLocalizationBundleImport,
+		},
+		Description: "Manage realm-level localization texts of several locales from Java .properties message bundles.",
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The realm in which the texts exists.",
+			},
+			"properties_content": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateDiagFunc: validateLocalizationBundleProperties,
+				DiffSuppressFunc: suppressLocalizationBundlePropertiesDiff,
+				Description:      "The mapping of locales to the content of their .properties message bundle.",
+			},
+			"remove_unmanaged_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, texts of the managed locales that are not part of their bundle are removed from the realm.",
+			},
+		},
+	}
+}
+
+func validateLocalizationBundleProperties(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for locale, content := range v.(map[string]interface{}) {
+		if _, err := parseJavaProperties(content.(string)); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("Invalid message bundle for locale %s", locale),
+				Detail:        err.Error(),
+				AttributePath: path.IndexString(locale),
+			})
+		}
+	}
+
+	return diags
+}
+
+// bundles with the same texts are equal, regardless of comments, ordering and escaping
+func suppressLocalizationBundlePropertiesDiff(k, old, new string, _ *schema.ResourceData) bool {
+	if strings.HasSuffix(k, ".%") {
+		return false
+	}
+
+	oldTexts, err := parseJavaProperties(old)
+	if err != nil {
+		return false
+	}
+
+	newTexts, err := parseJavaProperties(new)
+	if err != nil {
+		return false
+	}
+
+	return maps.Equal(oldTexts, newTexts)
+}
+
+func getLocalizationBundlesFromData(propertiesContent map[string]interface{}) (map[string]map[string]string, error) {
+	bundles := make(map[string]map[string]string, len(propertiesContent))
+
+	for locale, content := range propertiesContent {
+		texts, err := parseJavaProperties(content.(string))
+		if err != nil {
+			return nil, fmt.Errorf("invalid message bundle for locale %s: %v", locale, err)
+		}
+
+		bundles[locale] = texts
+	}
+
+	return bundles, nil
+}
+
+func resourceKeycloakRealmLocalizationBundleRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	removeUnmanagedKeys := data.Get("remove_unmanaged_keys").(bool)
+	propertiesContent := data.Get("properties_content").(map[string]interface{})
+
+	bundles, err := getLocalizationBundlesFromData(propertiesContent)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for locale, bundleTexts := range bundles {
+		realmTexts, err := keycloakClient.GetRealmLocalizationTexts(ctx, realmId, locale)
+		if err != nil {
+			return handleNotFoundError(ctx, err, data)
+		}
+
+		managedTexts := *realmTexts
+		if !removeUnmanagedKeys {
+			managedTexts = make(map[string]string)
+			for key := range bundleTexts {
+				if value, ok := (*realmTexts)[key]; ok {
+					managedTexts[key] = value
+				}
+			}
+		}
+
+		// the content is only replaced when the texts drifted, so the configured bundle is kept as is otherwise
+		if !maps.Equal(bundleTexts, managedTexts) {
+			propertiesContent[locale] = formatJavaProperties(managedTexts)
+		}
+	}
+
+	data.Set("properties_content", propertiesContent)
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationBundleUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	removeUnmanagedKeys := data.Get("remove_unmanaged_keys").(bool)
+
+	oldPropertiesContent, newPropertiesContent := data.GetChange("properties_content")
+
+	oldBundles, err := getLocalizationBundlesFromData(oldPropertiesContent.(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bundles, err := getLocalizationBundlesFromData(newPropertiesContent.(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for locale, oldBundleTexts := range oldBundles {
+		if _, ok := bundles[locale]; ok {
+			continue
+		}
+
+		err = deleteRealmLocalizationBundleTexts(ctx, keycloakClient, realmId, locale, oldBundleTexts)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	for locale, bundleTexts := range bundles {
+		realmTexts, err := keycloakClient.GetRealmLocalizationTexts(ctx, realmId, locale)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for key, value := range bundleTexts {
+			if realmValue, ok := (*realmTexts)[key]; ok && realmValue == value {
+				continue
+			}
+
+			err = keycloakClient.UpdateRealmLocalizationText(ctx, realmId, locale, key, value)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if !removeUnmanagedKeys {
+			continue
+		}
+
+		for key := range *realmTexts {
+			if _, ok := bundleTexts[key]; ok {
+				continue
+			}
+
+			err = keycloakClient.DeleteRealmLocalizationText(ctx, realmId, locale, key)
+			if err != nil && !keycloak.ErrorIs404(err) {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	data.SetId(realmId)
+
+	return resourceKeycloakRealmLocalizationBundleRead(ctx, data, meta)
+}
+
+// deleteRealmLocalizationBundleTexts removes the texts of a bundle that still exist in the realm
+func deleteRealmLocalizationBundleTexts(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, locale string, bundleTexts map[string]string) error {
+	realmTexts, err := keycloakClient.GetRealmLocalizationTexts(ctx, realmId, locale)
+	if err != nil {
+		return err
+	}
+
+	for key := range bundleTexts {
+		if _, ok := (*realmTexts)[key]; !ok {
+			continue
+		}
+
+		err = keycloakClient.DeleteRealmLocalizationText(ctx, realmId, locale, key)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationBundleDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	bundles, err := getLocalizationBundlesFromData(data.Get("properties_content").(map[string]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for locale, bundleTexts := range bundles {
+		err = deleteRealmLocalizationBundleTexts(ctx, keycloakClient, realmId, locale, bundleTexts)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmLocalizationBundleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realmId}}/{{locale1}},{{locale2}}")
+	}
+
+	propertiesContent := make(map[string]interface{})
+	for _, locale := range strings.Split(parts[1], ",") {
+		realmTexts, err := keycloakClient.GetRealmLocalizationTexts(ctx, parts[0], locale)
+		if err != nil {
+			return nil, err
+		}
+
+		propertiesContent[locale] = formatJavaProperties(*realmTexts)
+	}
+
+	d.SetId(parts[0])
+	d.Set("realm_id", parts[0])
+	d.Set("properties_content", propertiesContent)
+	d.Set("remove_unmanaged_keys", false)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmLocalizationBundle_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	germanBundle := `# German messages
+greeting = Grüße
+farewell: Auf Wiedersehen
+long=eins \
+     zwei
+`
+	frenchBundle := `greeting=Bonjour`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmLocalizationBundleDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLocalizationBundle_basic(realmName, germanBundle, frenchBundle, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmLocalizationBundleTexts(realmName, "de", map[string]string{
+						"greeting": "Grüße",
+						"farewell": "Auf Wiedersehen",
+						"long":     "eins zwei",
+					}),
+					testAccCheckKeycloakRealmLocalizationBundleTexts(realmName, "fr", map[string]string{
+						"greeting": "Bonjour",
+					}),
+				),
+			},
+			{
+				// a key that is not part of the bundle is kept until unmanaged keys are removed
+				PreConfig: func() {
+					err := keycloakClient.UpdateRealmLocalizationText(testCtx, realmName, "fr", "unmanaged", "value")
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testKeycloakRealmLocalizationBundle_basic(realmName, germanBundle, frenchBundle, false),
+				PlanOnly: true,
+			},
+			{
+				Config: testKeycloakRealmLocalizationBundle_basic(realmName, germanBundle, frenchBundle, true),
+				Check: testAccCheckKeycloakRealmLocalizationBundleTexts(realmName, "fr", map[string]string{
+					"greeting": "Bonjour",
+				}),
+			},
+			{
+				// texts changed outside of terraform are restored
+				PreConfig: func() {
+					err := keycloakClient.UpdateRealmLocalizationText(testCtx, realmName, "de", "greeting", "Hallo")
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testKeycloakRealmLocalizationBundle_basic(realmName, germanBundle, frenchBundle, true),
+				Check: testAccCheckKeycloakRealmLocalizationBundleTexts(realmName, "de", map[string]string{
+					"greeting": "Grüße",
+					"farewell": "Auf Wiedersehen",
+					"long":     "eins zwei",
+				}),
+			},
+			{
+				ResourceName:            "keycloak_realm_localization_bundle.bundle",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/de,fr", realmName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"properties_content", "remove_unmanaged_keys"},
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmLocalizationBundleTexts(realm, locale string, expectedTexts map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realmTexts, err := keycloakClient.GetRealmLocalizationTexts(testCtx, realm, locale)
+		if err != nil {
+			return err
+		}
+
+		if !maps.Equal(*realmTexts, expectedTexts) {
+			return fmt.Errorf("expected texts of locale %s to be %v, got %v", locale, expectedTexts, *realmTexts)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmLocalizationBundleDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_localization_bundle" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm_id"]
+
+			realmTexts, _ := keycloakClient.GetRealmLocalizationTexts(testCtx, realm, "de")
+			if realmTexts != nil && len(*realmTexts) != 0 {
+				return fmt.Errorf("texts for locale de still exist in realm %s", realm)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmLocalizationBundle_basic(realm, germanBundle, frenchBundle string, removeUnmanagedKeys bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+	internationalization {
+		supported_locales = ["en", "de", "fr"]
+		default_locale    = "en"
+	}
+}
+
+resource "keycloak_realm_localization_bundle" "bundle" {
+	realm_id              = keycloak_realm.realm.id
+	remove_unmanaged_keys = %t
+
+	properties_content = {
+		de = <<-EOT
+%s
+EOT
+		fr = %q
+	}
+}
+	`, realm, removeUnmanagedKeys, germanBundle, frenchBundle)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmLoginSettings() *schema.Resource {
+	resourceSchema := realmSettingsSchema(realmLoginSettingsKeys...)
+
+	// the realm defaults ssl_required to external, here it keeps the current value of the realm unless it is set
+	sslRequiredSchema := *resourceSchema["ssl_required"]
+	sslRequiredSchema.Default = nil
+	sslRequiredSchema.Computed = true
+	resourceSchema["ssl_required"] = &sslRequiredSchema
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmLoginSettingsCreate,
+		ReadContext:   resourceKeycloakRealmLoginSettingsRead,
+		UpdateContext: resourceKeycloakRealmLoginSettingsUpdate,
+		DeleteContext: resourceKeycloakRealmSettingsDelete,
+		// This resource can be imported using {{realm}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
+		Schema: resourceSchema,
+	}
+}
+
+func resourceKeycloakRealmLoginSettingsApply(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	// login settings that aren't set keep their current value, so they are applied directly to the realm
+	err := updateRealmSettings(ctx, keycloakClient, realmId, func(realm *keycloak.Realm) error {
+		getRealmLoginSettingsFromData(data, realm)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return nil
+}
+
+func resourceKeycloakRealmLoginSettingsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmLoginSettingsApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmLoginSettingsRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmLoginSettingsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Id()
+
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("realm_id", realmId)
+	setRealmLoginSettingsData(data, realm)
+
+	return nil
+}
+
+func resourceKeycloakRealmLoginSettingsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmLoginSettingsApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmLoginSettingsRead(ctx, data, meta)
+}
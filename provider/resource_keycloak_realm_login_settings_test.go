@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmLoginSettings_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLoginSettings_basic(realmName, true),
+				Check:  testAccCheckKeycloakRealmLoginSettingsRegistrationAllowed("keycloak_realm_login_settings.login_settings", true),
+			},
+			{
+				ResourceName:      "keycloak_realm_login_settings.login_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmLoginSettings_basic(realmName, false),
+				Check:  testAccCheckKeycloakRealmLoginSettingsRegistrationAllowed("keycloak_realm_login_settings.login_settings", false),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmLoginSettings_sslRequiredNotSet(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmLoginSettings_sslRequiredNotSet(realmName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmLoginSettingsSslRequired("keycloak_realm_login_settings.login_settings", "none"),
+					resource.TestCheckResourceAttr("keycloak_realm_login_settings.login_settings", "ssl_required", "none"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmLoginSettingsSslRequired(resourceName string, sslRequired string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm, err := keycloakClient.GetRealm(testCtx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if realm.SslRequired != sslRequired {
+			return fmt.Errorf("expected realm %s to have ssl required set to %s, but was %s", rs.Primary.ID, sslRequired, realm.SslRequired)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmLoginSettingsRegistrationAllowed(resourceName string, registrationAllowed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm, err := keycloakClient.GetRealm(testCtx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if realm.RegistrationAllowed != registrationAllowed {
+			return fmt.Errorf("expected realm %s to have registration allowed set to %t, but was %t", rs.Primary.ID, registrationAllowed, realm.RegistrationAllowed)
+		}
+
+		if !realm.RememberMe {
+			return fmt.Errorf("expected realm %s to have remember me enabled", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmLoginSettings_basic(realm string, registrationAllowed bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	lifecycle {
+		ignore_changes = [registration_allowed, remember_me, ssl_required]
+	}
+}
+
+resource "keycloak_realm_login_settings" "login_settings" {
+	realm_id = keycloak_realm.realm.id
+
+	registration_allowed = %t
+	remember_me          = true
+	ssl_required         = "all"
+}
+	`, realm, registrationAllowed)
+}
+
+func testKeycloakRealmLoginSettings_sslRequiredNotSet(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	ssl_required = "none"
+
+	lifecycle {
+		ignore_changes = [registration_allowed]
+	}
+}
+
+resource "keycloak_realm_login_settings" "login_settings" {
+	realm_id = keycloak_realm.realm.id
+
+	registration_allowed = true
+}
+	`, realm)
+}
@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// keycloak_realm_not_before revokes all tokens of a realm that were issued before the resource was created.
+// Changing the revision (or any other argument) recreates the resource, which moves the not-before policy to the current time again.
+func resourceKeycloakRealmNotBefore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmNotBeforeCreate,
+		ReadContext:   resourceKeycloakRealmNotBeforeRead,
+		DeleteContext: resourceKeycloakRealmNotBeforeDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"revision": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary value that, when changed, sets the not-before policy to the current time again.",
+			},
+			"push_revocation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Push the not-before policy to all clients of the realm that have an admin URL.",
+			},
+			"logout_all_sessions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Also sign out every active user session of the realm. The new policy is always pushed to the clients in this case.",
+			},
+			"not_before": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The not-before policy that was set, in seconds since the epoch.",
+			},
+		},
+	}
+}
+
+// globalRequestResultDiagnostics turns the admin URLs Keycloak could not reach into warnings, the revocation itself
+// already happened at this point so failing the apply would only leave the resource tainted
+func globalRequestResultDiagnostics(result *keycloak.GlobalRequestResult) diag.Diagnostics {
+	if result == nil || len(result.FailedRequests) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Not all clients could be notified",
+			Detail:   fmt.Sprintf("Keycloak failed to push the not-before policy to the following admin URLs: %s", strings.Join(result.FailedRequests, ", ")),
+		},
+	}
+}
+
+func resourceKeycloakRealmNotBeforeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	var result *keycloak.GlobalRequestResult
+
+	if data.Get("logout_all_sessions").(bool) {
+		var err error
+		result, err = keycloakClient.LogoutAllRealmSessions(ctx, realmId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		keycloakClient.Mutex.Lock(fmt.Sprintf("keycloakRealm:%s", realmId))
+		err := keycloakClient.SetRealmNotBefore(ctx, realmId, int(time.Now().Unix()))
+		keycloakClient.Mutex.Unlock(fmt.Sprintf("keycloakRealm:%s", realmId))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		if data.Get("push_revocation").(bool) {
+			result, err = keycloakClient.PushRealmRevocation(ctx, realmId)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+	data.Set("not_before", realm.NotBefore)
+
+	return append(globalRequestResultDiagnostics(result), resourceKeycloakRealmNotBeforeRead(ctx, data, meta)...)
+}
+
+func resourceKeycloakRealmNotBeforeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// the not-before policy is only ever moved forward, so there is nothing to reconcile as long as the realm exists
+	_, err := keycloakClient.GetRealm(ctx, data.Get("realm_id").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmNotBeforeDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// revoked tokens stay revoked
+	return nil
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmNotBefore_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmNotBefore_basic(realmName, "1", false, false),
+				Check:  testAccCheckKeycloakRealmNotBeforeMatchesState("keycloak_realm_not_before.not_before"),
+			},
+			{
+				Config: testKeycloakRealmNotBefore_basic(realmName, "2", true, false),
+				Check:  testAccCheckKeycloakRealmNotBeforeMatchesState("keycloak_realm_not_before.not_before"),
+			},
+			{
+				Config: testKeycloakRealmNotBefore_basic(realmName, "3", false, true),
+				Check:  testAccCheckKeycloakRealmNotBeforeMatchesState("keycloak_realm_not_before.not_before"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmNotBeforeMatchesState(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm, err := keycloakClient.GetRealm(testCtx, rs.Primary.Attributes["realm_id"])
+		if err != nil {
+			return err
+		}
+
+		notBefore, err := strconv.Atoi(rs.Primary.Attributes["not_before"])
+		if err != nil {
+			return err
+		}
+
+		if notBefore == 0 || realm.NotBefore != notBefore {
+			return fmt.Errorf("expected realm %s to have not-before policy %d, but was %d", realm.Realm, notBefore, realm.NotBefore)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmNotBefore_basic(realm, revision string, pushRevocation, logoutAllSessions bool) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+}
+
+resource "keycloak_realm_not_before" "not_before" {
+	realm_id            = keycloak_realm.realm.id
+	revision            = "%s"
+	push_revocation     = %t
+	logout_all_sessions = %t
+}
+	`, realm, revision, pushRevocation, logoutAllSessions)
+}
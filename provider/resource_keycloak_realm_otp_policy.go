@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmOtpPolicy() *schema.Resource {
+	otpPolicySchema := resourceKeycloakRealm().Schema["otp_policy"].Elem.(*schema.Resource).Schema
+
+	resourceSchema := realmSettingsSchema()
+	for key, value := range otpPolicySchema {
+		resourceSchema[key] = value
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmOtpPolicyCreate,
+		ReadContext:   resourceKeycloakRealmOtpPolicyRead,
+		UpdateContext: resourceKeycloakRealmOtpPolicyUpdate,
+		DeleteContext: resourceKeycloakRealmSettingsDelete,
+		// This resource can be imported using {{realm}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
+		Schema: resourceSchema,
+	}
+}
+
+func resourceKeycloakRealmOtpPolicyApply(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	otpPolicy := &keycloak.Realm{}
+	getRealmOtpPolicyFromSettings(otpPolicy, map[string]interface{}{
+		"type":              data.Get("type"),
+		"algorithm":         data.Get("algorithm"),
+		"digits":            data.Get("digits"),
+		"initial_counter":   data.Get("initial_counter"),
+		"look_ahead_window": data.Get("look_ahead_window"),
+		"period":            data.Get("period"),
+		"code_reusable":     data.Get("code_reusable"),
+	})
+
+	err := updateRealmSettings(ctx, keycloakClient, realmId, func(realm *keycloak.Realm) error {
+		copyRealmOtpPolicy(realm, otpPolicy)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return nil
+}
+
+func resourceKeycloakRealmOtpPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmOtpPolicyApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmOtpPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmOtpPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Id()
+
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("realm_id", realmId)
+	for key, value := range getRealmOtpPolicySettings(realm) {
+		data.Set(key, value)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmOtpPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmOtpPolicyApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmOtpPolicyRead(ctx, data, meta)
+}
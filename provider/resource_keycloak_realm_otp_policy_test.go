@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmOtpPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmOtpPolicy_basic(realmName, 8),
+				Check:  testAccCheckKeycloakRealmOtpPolicyDigits("keycloak_realm_otp_policy.otp_policy", 8),
+			},
+			{
+				ResourceName:      "keycloak_realm_otp_policy.otp_policy",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmOtpPolicy_basic(realmName, 6),
+				Check:  testAccCheckKeycloakRealmOtpPolicyDigits("keycloak_realm_otp_policy.otp_policy", 6),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmOtpPolicyDigits(resourceName string, digits int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm, err := keycloakClient.GetRealm(testCtx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if realm.OTPPolicyDigits != digits {
+			return fmt.Errorf("expected realm %s to have otp digits set to %d, but was %d", rs.Primary.ID, digits, realm.OTPPolicyDigits)
+		}
+
+		if realm.OTPPolicyAlgorithm != "HmacSHA256" {
+			return fmt.Errorf("expected realm %s to have otp algorithm set to HmacSHA256, but was %s", rs.Primary.ID, realm.OTPPolicyAlgorithm)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmOtpPolicy_basic(realm string, digits int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	lifecycle {
+		ignore_changes = [otp_policy]
+	}
+}
+
+resource "keycloak_realm_otp_policy" "otp_policy" {
+	realm_id = keycloak_realm.realm.id
+
+	algorithm = "HmacSHA256"
+	digits    = %d
+	period    = 60
+}
+	`, realm, digits)
+}
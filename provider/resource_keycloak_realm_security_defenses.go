@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmSecurityDefenses() *schema.Resource {
+	securityDefensesSchema := resourceKeycloakRealm().Schema["security_defenses"].Elem.(*schema.Resource).Schema
+
+	resourceSchema := realmSettingsSchema()
+	for key, value := range securityDefensesSchema {
+		resourceSchema[key] = value
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmSecurityDefensesCreate,
+		ReadContext:   resourceKeycloakRealmSecurityDefensesRead,
+		UpdateContext: resourceKeycloakRealmSecurityDefensesUpdate,
+		DeleteContext: resourceKeycloakRealmSettingsDelete,
+		// This resource can be imported using {{realm}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSecurityDefensesImport,
+		},
+		Schema: resourceSchema,
+	}
+}
+
+func resourceKeycloakRealmSecurityDefensesApply(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	keycloakVersion, err := keycloakClient.Version(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	securityDefenses := &keycloak.Realm{}
+	getRealmSecurityDefensesFromSettings(securityDefenses, data.Get("headers").([]interface{}), data.Get("brute_force_detection").([]interface{}), keycloakVersion)
+
+	err = updateRealmSettings(ctx, keycloakClient, realmId, func(realm *keycloak.Realm) error {
+		copyRealmSecurityDefenses(realm, securityDefenses)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return nil
+}
+
+func resourceKeycloakRealmSecurityDefensesCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmSecurityDefensesApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmSecurityDefensesRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmSecurityDefensesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keycloakVersion, err := keycloakClient.Version(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	realmId := data.Id()
+
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("realm_id", realmId)
+
+	// the headers always have a value on the server, so they are only tracked when they are configured
+	if len(data.Get("headers").([]interface{})) == 1 {
+		data.Set("headers", []interface{}{getHeaderSettings(realm)})
+	}
+
+	if realm.BruteForceProtected {
+		data.Set("brute_force_detection", []interface{}{getBruteForceDetectionSettings(realm, keycloakVersion)})
+	} else {
+		data.Set("brute_force_detection", nil)
+	}
+
+	return nil
+}
+
+func resourceKeycloakRealmSecurityDefensesUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmSecurityDefensesApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmSecurityDefensesRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmSecurityDefensesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realm, err := keycloakClient.GetRealm(ctx, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("realm_id", d.Id())
+	d.Set("headers", []interface{}{getHeaderSettings(realm)})
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmSecurityDefenses_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSecurityDefenses_basic(realmName, "DENY", 10),
+				Check:  testAccCheckKeycloakRealmSecurityDefenses("keycloak_realm_security_defenses.security_defenses", "DENY", 10),
+			},
+			{
+				ResourceName:      "keycloak_realm_security_defenses.security_defenses",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmSecurityDefenses_basic(realmName, "SAMEORIGIN", 5),
+				Check:  testAccCheckKeycloakRealmSecurityDefenses("keycloak_realm_security_defenses.security_defenses", "SAMEORIGIN", 5),
+			},
+			{
+				Config: testKeycloakRealmSecurityDefenses_headersOnly(realmName),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm_security_defenses.security_defenses", "brute_force_detection.#", "0"),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmSecurityDefenses(resourceName, xFrameOptions string, maxLoginFailures int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm, err := keycloakClient.GetRealm(testCtx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if realm.BrowserSecurityHeaders.XFrameOptions != xFrameOptions {
+			return fmt.Errorf("expected realm %s to have x-frame-options set to %s, but was %s", rs.Primary.ID, xFrameOptions, realm.BrowserSecurityHeaders.XFrameOptions)
+		}
+
+		if !realm.BruteForceProtected {
+			return fmt.Errorf("expected realm %s to have brute force detection enabled", rs.Primary.ID)
+		}
+
+		if realm.FailureFactor != maxLoginFailures {
+			return fmt.Errorf("expected realm %s to have max login failures set to %d, but was %d", rs.Primary.ID, maxLoginFailures, realm.FailureFactor)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmSecurityDefenses_basic(realm, xFrameOptions string, maxLoginFailures int) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	lifecycle {
+		ignore_changes = [security_defenses]
+	}
+}
+
+resource "keycloak_realm_security_defenses" "security_defenses" {
+	realm_id = keycloak_realm.realm.id
+
+	headers {
+		x_frame_options = "%s"
+	}
+
+	brute_force_detection {
+		max_login_failures = %d
+	}
+}
+	`, realm, xFrameOptions, maxLoginFailures)
+}
+
+func testKeycloakRealmSecurityDefenses_headersOnly(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	lifecycle {
+		ignore_changes = [security_defenses]
+	}
+}
+
+resource "keycloak_realm_security_defenses" "security_defenses" {
+	realm_id = keycloak_realm.realm.id
+
+	headers {
+		x_frame_options = "DENY"
+	}
+}
+	`, realm)
+}
@@ -0,0 +1,308 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak/types"
+)
+
+func resourceKeycloakRealmSmtpServer() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmSmtpServerCreate,
+		ReadContext:   resourceKeycloakRealmSmtpServerRead,
+		UpdateContext: resourceKeycloakRealmSmtpServerUpdate,
+		DeleteContext: resourceKeycloakRealmSmtpServerDelete,
+		// This resource can be imported using {{realm}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSmtpServerImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"port": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"from": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"from_display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reply_to": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"reply_to_display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"envelope_from": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"starttls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"ssl": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"allow_utf8": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"auth": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"token_auth"},
+				MaxItems:      1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"password": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+			"token_auth": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				ConflictsWith: []string{"auth"},
+				MaxItems:      1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"client_secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"scope": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"verify_connection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, Keycloak sends a test email with these settings before they are saved, and the apply fails if the email cannot be delivered.",
+			},
+		},
+	}
+}
+
+func getRealmSmtpServerFromData(data *schema.ResourceData) *keycloak.SmtpServer {
+	smtpServer := &keycloak.SmtpServer{
+		StartTls:           types.KeycloakBoolQuoted(data.Get("starttls").(bool)),
+		Port:               data.Get("port").(string),
+		Host:               data.Get("host").(string),
+		ReplyTo:            data.Get("reply_to").(string),
+		ReplyToDisplayName: data.Get("reply_to_display_name").(string),
+		From:               data.Get("from").(string),
+		FromDisplayName:    data.Get("from_display_name").(string),
+		EnvelopeFrom:       data.Get("envelope_from").(string),
+		Ssl:                types.KeycloakBoolQuoted(data.Get("ssl").(bool)),
+		AllowUtf8:          types.KeycloakBoolQuoted(data.Get("allow_utf8").(bool)),
+	}
+
+	authConfig := data.Get("auth").([]interface{})
+	tokenAuthConfig := data.Get("token_auth").([]interface{})
+
+	if len(authConfig) == 1 {
+		auth := authConfig[0].(map[string]interface{})
+
+		smtpServer.Auth = true
+		smtpServer.AuthType = "basic"
+		smtpServer.User = auth["username"].(string)
+		smtpServer.Password = auth["password"].(string)
+	} else if len(tokenAuthConfig) == 1 {
+		tokenAuth := tokenAuthConfig[0].(map[string]interface{})
+
+		smtpServer.Auth = true
+		smtpServer.AuthType = "token"
+		smtpServer.User = tokenAuth["username"].(string)
+		smtpServer.AuthTokenUrl = tokenAuth["url"].(string)
+		smtpServer.AuthTokenClientId = tokenAuth["client_id"].(string)
+		smtpServer.AuthTokenClientSecret = tokenAuth["client_secret"].(string)
+		smtpServer.AuthTokenScope = tokenAuth["scope"].(string)
+	} else {
+		smtpServer.Auth = false
+	}
+
+	return smtpServer
+}
+
+// getRealmSmtpServerSecretFromData returns a secret of the auth or token_auth block in the state. Keycloak only responds
+// with "**********" for secrets, so the value in the state is kept to detect changes.
+func getRealmSmtpServerSecretFromData(data *schema.ResourceData, block, key string) (string, bool) {
+	config := data.Get(block).([]interface{})
+	if len(config) != 1 || config[0] == nil {
+		return "", false
+	}
+
+	return config[0].(map[string]interface{})[key].(string), true
+}
+
+func setRealmSmtpServerData(data *schema.ResourceData, realmId string, smtpServer *keycloak.SmtpServer) {
+	data.SetId(realmId)
+
+	data.Set("realm_id", realmId)
+	data.Set("host", smtpServer.Host)
+	data.Set("port", smtpServer.Port)
+	data.Set("from", smtpServer.From)
+	data.Set("from_display_name", smtpServer.FromDisplayName)
+	data.Set("reply_to", smtpServer.ReplyTo)
+	data.Set("reply_to_display_name", smtpServer.ReplyToDisplayName)
+	data.Set("envelope_from", smtpServer.EnvelopeFrom)
+	data.Set("starttls", smtpServer.StartTls)
+	data.Set("ssl", smtpServer.Ssl)
+	data.Set("allow_utf8", smtpServer.AllowUtf8)
+
+	if smtpServer.Auth && smtpServer.AuthType == "token" {
+		data.Set("auth", nil)
+		data.Set("token_auth", []interface{}{
+			map[string]interface{}{
+				"username":      smtpServer.User,
+				"url":           smtpServer.AuthTokenUrl,
+				"client_id":     smtpServer.AuthTokenClientId,
+				"client_secret": smtpServer.AuthTokenClientSecret,
+				"scope":         smtpServer.AuthTokenScope,
+			},
+		})
+	} else if smtpServer.Auth {
+		data.Set("auth", []interface{}{
+			map[string]interface{}{
+				"username": smtpServer.User,
+				"password": smtpServer.Password,
+			},
+		})
+		data.Set("token_auth", nil)
+	} else {
+		data.Set("auth", nil)
+		data.Set("token_auth", nil)
+	}
+}
+
+func resourceKeycloakRealmSmtpServerApply(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	smtpServer := getRealmSmtpServerFromData(data)
+
+	if data.Get("verify_connection").(bool) {
+		err := keycloakClient.TestRealmSmtpServer(ctx, realmId, smtpServer)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	keycloakClient.Mutex.Lock(fmt.Sprintf("keycloakRealm:%s", realmId))
+	defer keycloakClient.Mutex.Unlock(fmt.Sprintf("keycloakRealm:%s", realmId))
+
+	err := keycloakClient.UpdateRealmSmtpServer(ctx, realmId, smtpServer)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return nil
+}
+
+func resourceKeycloakRealmSmtpServerCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmSmtpServerApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmSmtpServerRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmSmtpServerRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Id()
+
+	smtpServer, err := keycloakClient.GetRealmSmtpServer(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if (keycloak.SmtpServer{}) == *smtpServer {
+		data.SetId("")
+		return nil
+	}
+
+	if password, ok := getRealmSmtpServerSecretFromData(data, "auth", "password"); ok {
+		smtpServer.Password = password
+	}
+	if clientSecret, ok := getRealmSmtpServerSecretFromData(data, "token_auth", "client_secret"); ok {
+		smtpServer.AuthTokenClientSecret = clientSecret
+	}
+
+	setRealmSmtpServerData(data, realmId, smtpServer)
+
+	return nil
+}
+
+func resourceKeycloakRealmSmtpServerUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmSmtpServerApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmSmtpServerRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmSmtpServerDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	keycloakClient.Mutex.Lock(fmt.Sprintf("keycloakRealm:%s", realmId))
+	defer keycloakClient.Mutex.Unlock(fmt.Sprintf("keycloakRealm:%s", realmId))
+
+	return diag.FromErr(keycloakClient.UpdateRealmSmtpServer(ctx, realmId, &keycloak.SmtpServer{}))
+}
+
+func resourceKeycloakRealmSmtpServerImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	d.Set("realm_id", d.Id())
+	d.Set("verify_connection", false)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmSmtpServer_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost.com", "user", "secret"),
+				Check:  testAccCheckKeycloakRealmSmtpServer("keycloak_realm_smtp_server.smtp", "myhost.com", "user"),
+			},
+			{
+				ResourceName:            "keycloak_realm_smtp_server.smtp",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auth.0.password"},
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost2.com", "user2", "secret"),
+				Check:  testAccCheckKeycloakRealmSmtpServer("keycloak_realm_smtp_server.smtp", "myhost2.com", "user2"),
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_tokenAuth(realmName, "myhost2.com", "user3", "secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmSmtpServer("keycloak_realm_smtp_server.smtp", "myhost2.com", "user3"),
+					resource.TestCheckResourceAttr("keycloak_realm_smtp_server.smtp", "auth.#", "0"),
+					resource.TestCheckResourceAttr("keycloak_realm_smtp_server.smtp", "token_auth.0.url", "https://auth.myhost.com/token"),
+				),
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_realmOnly(realmName),
+				Check:  testAccCheckKeycloakRealmSmtp("keycloak_realm.realm", "", "", ""),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmSmtpServer_updateSecrets(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	resourceName := "keycloak_realm_smtp_server.smtp"
+
+	// Keycloak never returns secrets, so a secret that changes has to be planned as an update and sent again
+	expectUpdate := resource.ConfigPlanChecks{
+		PreApply: []plancheck.PlanCheck{
+			plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+		},
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmSmtpServer_basic(realmName, "myhost.com", "user", "secret"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "auth.0.password", "secret"),
+			},
+			{
+				Config:           testKeycloakRealmSmtpServer_basic(realmName, "myhost.com", "user", "rotated"),
+				ConfigPlanChecks: expectUpdate,
+				Check:            resource.TestCheckResourceAttr(resourceName, "auth.0.password", "rotated"),
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_tokenAuth(realmName, "myhost.com", "user", "secret"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "token_auth.0.client_secret", "secret"),
+			},
+			{
+				Config:           testKeycloakRealmSmtpServer_tokenAuth(realmName, "myhost.com", "user", "rotated"),
+				ConfigPlanChecks: expectUpdate,
+				Check:            resource.TestCheckResourceAttr(resourceName, "token_auth.0.client_secret", "rotated"),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealmSmtpServer_verifyConnection(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRealmSmtpServer_verifyConnection(realmName),
+				ExpectError: regexp.MustCompile("smtp connection test for realm .+ failed"),
+			},
+			{
+				Config: testKeycloakRealmSmtpServer_realmOnly(realmName),
+				Check:  testAccCheckKeycloakRealmSmtp("keycloak_realm.realm", "", "", ""),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmSmtpServer(resourceName, host, user string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		smtpServer, err := keycloakClient.GetRealmSmtpServer(testCtx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if smtpServer.Host != host {
+			return fmt.Errorf("expected realm %s to have smtp host set to %s, but was %s", rs.Primary.ID, host, smtpServer.Host)
+		}
+
+		if smtpServer.User != user {
+			return fmt.Errorf("expected realm %s to have smtp user set to %s, but was %s", rs.Primary.ID, user, smtpServer.User)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmSmtpServer_realmOnly(realm string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm   = "%s"
+	enabled = true
+
+	lifecycle {
+		ignore_changes = [smtp_server]
+	}
+}
+	`, realm)
+}
+
+func testKeycloakRealmSmtpServer_basic(realm, host, user, password string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_realm_smtp_server" "smtp" {
+	realm_id = keycloak_realm.realm.id
+
+	host              = "%s"
+	port              = "25"
+	from              = "keycloak@myhost.com"
+	from_display_name = "Keycloak"
+	reply_to          = "noreply@myhost.com"
+	starttls          = true
+
+	auth {
+		username = "%s"
+		password = "%s"
+	}
+}
+	`, testKeycloakRealmSmtpServer_realmOnly(realm), host, user, password)
+}
+
+func testKeycloakRealmSmtpServer_tokenAuth(realm, host, user, clientSecret string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_realm_smtp_server" "smtp" {
+	realm_id = keycloak_realm.realm.id
+
+	host = "%s"
+	port = "587"
+	from = "keycloak@myhost.com"
+
+	token_auth {
+		username      = "%s"
+		url           = "https://auth.myhost.com/token"
+		client_id     = "smtp"
+		client_secret = "%s"
+		scope         = "smtp.send"
+	}
+}
+	`, testKeycloakRealmSmtpServer_realmOnly(realm), host, user, clientSecret)
+}
+
+func testKeycloakRealmSmtpServer_verifyConnection(realm string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "keycloak_realm_smtp_server" "smtp" {
+	realm_id = keycloak_realm.realm.id
+
+	host = "localhost"
+	port = "1"
+	from = "keycloak@myhost.com"
+
+	verify_connection = true
+}
+	`, testKeycloakRealmSmtpServer_realmOnly(realm))
+}
@@ -713,6 +713,107 @@ func TestAccKeycloakRealm_passwordPolicy(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRealm_passwordPolicyReordered(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
+	passwordPolicy := "upperCase(1) and length(8) and passwordHistory(5)"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_passwordPolicy(realmName, realmDisplayName, passwordPolicy),
+				Check:  testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", passwordPolicy),
+			},
+			{
+				Config:   testKeycloakRealm_passwordPolicy(realmName, realmDisplayName, "passwordHistory(5) and length(8) and upperCase(1)"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_cibaAndParPolicies(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_cibaAndParPolicies(realmName, "ping", 300, "2m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmCibaAndParPolicies("keycloak_realm.realm", "ping", 300, 120),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "ciba_policy.0.interval", "10"),
+				),
+			},
+			{
+				Config: testKeycloakRealm_cibaAndParPolicies(realmName, "poll", 60, "90s"),
+				Check:  testAccCheckKeycloakRealmCibaAndParPolicies("keycloak_realm.realm", "poll", 60, 90),
+			},
+			// the policies are kept when they are no longer configured
+			{
+				Config: testKeycloakRealm_basic(realmName, "", ""),
+				Check:  testAccCheckKeycloakRealmCibaAndParPolicies("keycloak_realm.realm", "poll", 60, 90),
+			},
+			{
+				Config:      testKeycloakRealm_cibaAndParPolicies(realmName, "push", 60, "90s"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected ciba_policy.0.backchannel_token_delivery_mode to be one of`),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRealm_passwordPolicyRules(t *testing.T) {
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealm_passwordPolicyRules(realmName, realmDisplayName, map[string]string{"upperCase": "1", "length": "12", "notUsername": ""}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "length(12) and notUsername and upperCase(1)"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy", ""),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy_rule.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("keycloak_realm.realm", "password_policy_rule.*", map[string]string{
+						"type":  "length",
+						"value": "12",
+					}),
+				),
+			},
+			{
+				Config: testKeycloakRealm_passwordPolicyRules(realmName, realmDisplayName, map[string]string{"length": "14", "passwordHistory": "5"}),
+				Check:  testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "length(14) and passwordHistory(5)"),
+			},
+			{
+				Config:      testKeycloakRealm_passwordPolicyRules(realmName, realmDisplayName, map[string]string{"lenght": "14"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("validation error: password-policy \"lenght\" does not exist on the server"),
+			},
+			{
+				Config:      testKeycloakRealm_passwordPolicyRules(realmName, realmDisplayName, map[string]string{"length": "fourteen"}),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("validation error: password-policy \"length\" expects an integer value"),
+			},
+			{
+				Config: testKeycloakRealm_passwordPolicy(realmName, realmDisplayName, "length(14) and passwordHistory(5)"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmPasswordPolicy("keycloak_realm.realm", "length(14) and passwordHistory(5)"),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "password_policy_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRealm_browserFlow(t *testing.T) {
 	realmName := acctest.RandomWithPrefix("tf-acc")
 	realmDisplayName := acctest.RandomWithPrefix("tf-acc")
@@ -1923,6 +2024,27 @@ resource "keycloak_realm" "realm" {
 	`, realm, realmDisplayName, passwordPolicy)
 }
 
+func testKeycloakRealm_passwordPolicyRules(realm, realmDisplayName string, rules map[string]string) string {
+	var passwordPolicyRules string
+	for ruleType, value := range rules {
+		passwordPolicyRules += fmt.Sprintf(`
+	password_policy_rule {
+		type  = "%s"
+		value = "%s"
+	}
+`, ruleType, value)
+	}
+
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	enabled      = true
+	display_name = "%s"
+%s
+}
+	`, realm, realmDisplayName, passwordPolicyRules)
+}
+
 func testKeycloakRealm_browserFlow(realm, realmDisplayName, browserFlow string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -2073,3 +2195,47 @@ resource "keycloak_realm" "realm" {
 }
 `, realm, displayName, displayNameHtml)
 }
+
+func testAccCheckKeycloakRealmCibaAndParPolicies(resourceName, tokenDeliveryMode string, expiresIn, parRequestUriLifespan int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		realm, err := getRealmFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		cibaPolicy := realm.GetCibaPolicy()
+		if cibaPolicy == nil {
+			return fmt.Errorf("expected realm %s to have a CIBA policy", realm.Realm)
+		}
+
+		if cibaPolicy.BackchannelTokenDeliveryMode != tokenDeliveryMode {
+			return fmt.Errorf("expected realm %s to have backchannel token delivery mode %s, but was %s", realm.Realm, tokenDeliveryMode, cibaPolicy.BackchannelTokenDeliveryMode)
+		}
+
+		if cibaPolicy.ExpiresIn != expiresIn {
+			return fmt.Errorf("expected realm %s to have CIBA requests expire in %d seconds, but was %d", realm.Realm, expiresIn, cibaPolicy.ExpiresIn)
+		}
+
+		if lifespan, _ := realm.GetParRequestUriLifespan(); lifespan != parRequestUriLifespan {
+			return fmt.Errorf("expected realm %s to have a PAR request URI lifespan of %d seconds, but was %d", realm.Realm, parRequestUriLifespan, lifespan)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealm_cibaAndParPolicies(realm, tokenDeliveryMode string, expiresIn int, parRequestUriLifespan string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	ciba_policy {
+		backchannel_token_delivery_mode = "%s"
+		expires_in                      = %d
+		interval                        = 10
+	}
+
+	par_request_uri_lifespan = "%s"
+}
+	`, realm, tokenDeliveryMode, expiresIn, parRequestUriLifespan)
+}
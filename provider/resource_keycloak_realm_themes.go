@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmThemes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmThemesCreate,
+		ReadContext:   resourceKeycloakRealmThemesRead,
+		UpdateContext: resourceKeycloakRealmThemesUpdate,
+		DeleteContext: resourceKeycloakRealmSettingsDelete,
+		// This resource can be imported using {{realm}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
+		Schema: realmSettingsSchema(realmThemesKeys...),
+	}
+}
+
+func resourceKeycloakRealmThemesApply(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	themes := &keycloak.Realm{}
+	getRealmThemesFromData(data, themes)
+
+	err := updateRealmSettings(ctx, keycloakClient, realmId, func(realm *keycloak.Realm) error {
+		copyRealmThemes(realm, themes)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return nil
+}
+
+func resourceKeycloakRealmThemesCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmThemesApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmThemesRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmThemesRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Id()
+
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("realm_id", realmId)
+	setRealmThemesData(data, realm)
+
+	return nil
+}
+
+func resourceKeycloakRealmThemesUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmThemesApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmThemesRead(ctx, data, meta)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakRealmThemes_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmThemes_basic(realmName, "base"),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm_themes.themes", "email_theme", "base"),
+			},
+			{
+				ResourceName:      "keycloak_realm_themes.themes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmThemes_basic(realmName, "keycloak"),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm_themes.themes", "email_theme", "keycloak"),
+			},
+		},
+	})
+}
+
+func testKeycloakRealmThemes_basic(realm, emailTheme string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	lifecycle {
+		ignore_changes = [login_theme, account_theme, admin_theme, email_theme]
+	}
+}
+
+resource "keycloak_realm_themes" "themes" {
+	realm_id = keycloak_realm.realm.id
+
+	login_theme = "keycloak"
+	email_theme = "%s"
+}
+	`, realm, emailTheme)
+}
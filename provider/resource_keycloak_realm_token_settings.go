@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmTokenSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmTokenSettingsCreate,
+		ReadContext:   resourceKeycloakRealmTokenSettingsRead,
+		UpdateContext: resourceKeycloakRealmTokenSettingsUpdate,
+		DeleteContext: resourceKeycloakRealmSettingsDelete,
+		// This resource can be imported using {{realm}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
+		Schema: realmSettingsSchema(realmTokenSettingsKeys...),
+	}
+}
+
+func resourceKeycloakRealmTokenSettingsApply(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	tokenSettings := &keycloak.Realm{}
+	err := getRealmTokenSettingsFromData(data, tokenSettings)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateRealmSettings(ctx, keycloakClient, realmId, func(realm *keycloak.Realm) error {
+		copyRealmTokenSettings(realm, tokenSettings)
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return nil
+}
+
+func resourceKeycloakRealmTokenSettingsCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmTokenSettingsApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmTokenSettingsRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmTokenSettingsRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Id()
+
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("realm_id", realmId)
+	setRealmTokenSettingsData(data, realm)
+
+	return nil
+}
+
+func resourceKeycloakRealmTokenSettingsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmTokenSettingsApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmTokenSettingsRead(ctx, data, meta)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmTokenSettings_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmTokenSettings_basic(realmName, "Realm", "10m"),
+				Check:  testAccCheckKeycloakRealmTokenSettingsAccessTokenLifespan("keycloak_realm_token_settings.token_settings", 600),
+			},
+			{
+				ResourceName:      "keycloak_realm_token_settings.token_settings",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmTokenSettings_basic(realmName, "Realm", "15m"),
+				Check:  testAccCheckKeycloakRealmTokenSettingsAccessTokenLifespan("keycloak_realm_token_settings.token_settings", 900),
+			},
+			// updating the realm itself should not revert the token settings
+			{
+				Config: testKeycloakRealmTokenSettings_basic(realmName, "Updated Realm", "15m"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmTokenSettingsAccessTokenLifespan("keycloak_realm_token_settings.token_settings", 900),
+					resource.TestCheckResourceAttr("keycloak_realm.realm", "display_name", "Updated Realm"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmTokenSettingsAccessTokenLifespan(resourceName string, accessTokenLifespan int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realm, err := keycloakClient.GetRealm(testCtx, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		if realm.AccessTokenLifespan != accessTokenLifespan {
+			return fmt.Errorf("expected realm %s to have access token lifespan %d, but was %d", rs.Primary.ID, accessTokenLifespan, realm.AccessTokenLifespan)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmTokenSettings_basic(realm, displayName, accessTokenLifespan string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm        = "%s"
+	display_name = "%s"
+
+	lifecycle {
+		ignore_changes = [access_token_lifespan, sso_session_idle_timeout, revoke_refresh_token]
+	}
+}
+
+resource "keycloak_realm_token_settings" "token_settings" {
+	realm_id = keycloak_realm.realm.id
+
+	access_token_lifespan    = "%s"
+	sso_session_idle_timeout = "1h"
+	revoke_refresh_token     = true
+}
+	`, realm, displayName, accessTokenLifespan)
+}
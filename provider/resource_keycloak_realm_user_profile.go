@@ -38,112 +38,14 @@ func resourceKeycloakRealmUserProfile() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"display_name": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"default_value": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"multi_valued": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Default:  false,
-						},
-						"group": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"enabled_when_scope": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"required_for_roles": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"required_for_scopes": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-						"permissions": {
-							Type:     schema.TypeList,
-							Optional: true,
-							MaxItems: 1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"view": {
-										Type:     schema.TypeSet,
-										Set:      schema.HashString,
-										Required: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-									"edit": {
-										Type:     schema.TypeSet,
-										Set:      schema.HashString,
-										Required: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-								},
-							},
-						},
-						"validator": {
-							Type:     schema.TypeSet,
-							Optional: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:     schema.TypeString,
-										Required: true,
-									},
-									"config": {
-										Type:     schema.TypeMap,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
-									},
-								},
-							},
-						},
-						"annotations": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-					},
+					Schema: realmUserProfileAttributeSchema(),
 				},
 			},
 			"group": {
 				Type:     schema.TypeSet,
 				Optional: true,
 				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"display_header": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"display_description": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"annotations": {
-							Type:     schema.TypeMap,
-							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
-						},
-					},
+					Schema: realmUserProfileGroupSchema(),
 				},
 			},
 			"unmanaged_attribute_policy": {
@@ -156,6 +58,116 @@ func resourceKeycloakRealmUserProfile() *schema.Resource {
 	}
 }
 
+// realmUserProfileAttributeSchema is the schema of an attribute of the user profile, shared by the attribute blocks of
+// keycloak_realm_user_profile and the keycloak_realm_user_profile_attribute resource
+func realmUserProfileAttributeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"default_value": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"multi_valued": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"group": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"enabled_when_scope": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"required_for_roles": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"required_for_scopes": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+		"permissions": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"view": {
+						Type:     schema.TypeSet,
+						Set:      schema.HashString,
+						Required: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"edit": {
+						Type:     schema.TypeSet,
+						Set:      schema.HashString,
+						Required: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"validator": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"config": {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"annotations": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// realmUserProfileGroupSchema is the schema of a group of the user profile, shared by the group blocks of
+// keycloak_realm_user_profile and the keycloak_realm_user_profile_group resource
+func realmUserProfileGroupSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"display_header": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"display_description": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"annotations": {
+			Type:     schema.TypeMap,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
 func getRealmUserProfileAttributeFromData(m map[string]interface{}) *keycloak.RealmUserProfileAttribute {
 	attribute := &keycloak.RealmUserProfileAttribute{
 		Name:         m["name"].(string),
@@ -468,6 +480,9 @@ func resourceKeycloakRealmUserProfileCreate(ctx context.Context, data *schema.Re
 		}
 	}
 
+	keycloakClient.Mutex.Lock(realmUserProfileMutexKey(realmId))
+	defer keycloakClient.Mutex.Unlock(realmUserProfileMutexKey(realmId))
+
 	err = keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
 	if err != nil {
 		return diag.FromErr(err)
@@ -505,6 +520,9 @@ func resourceKeycloakRealmUserProfileDelete(ctx context.Context, data *schema.Re
 		UnmanagedAttributePolicy: nil,
 	}
 
+	keycloakClient.Mutex.Lock(realmUserProfileMutexKey(realmId))
+	defer keycloakClient.Mutex.Unlock(realmUserProfileMutexKey(realmId))
+
 	err := keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
 	if err != nil {
 		return diag.FromErr(err)
@@ -529,6 +547,9 @@ func resourceKeycloakRealmUserProfileUpdate(ctx context.Context, data *schema.Re
 		}
 	}
 
+	keycloakClient.Mutex.Lock(realmUserProfileMutexKey(realmId))
+	defer keycloakClient.Mutex.Unlock(realmUserProfileMutexKey(realmId))
+
 	err = keycloakClient.UpdateRealmUserProfile(ctx, realmId, realmUserProfile)
 	if err != nil {
 		return diag.FromErr(err)
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// keycloak_realm_user_profile_attribute manages a single attribute of the user profile. Unlike
+// keycloak_realm_user_profile, it leaves all other attributes and groups of the user profile untouched.
+func resourceKeycloakRealmUserProfileAttribute() *schema.Resource {
+	attributeSchema := realmUserProfileAttributeSchema()
+	attributeSchema["name"].ForceNew = true
+	attributeSchema["realm_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmUserProfileAttributeCreate,
+		ReadContext:   resourceKeycloakRealmUserProfileAttributeRead,
+		UpdateContext: resourceKeycloakRealmUserProfileAttributeUpdate,
+		DeleteContext: resourceKeycloakRealmUserProfileAttributeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmUserProfileEntryImport,
+		},
+		Schema: attributeSchema,
+	}
+}
+
+func realmUserProfileMutexKey(realmId string) string {
+	return fmt.Sprintf("keycloakRealmUserProfile:%s", realmId)
+}
+
+func getRealmUserProfileAttributeFromResourceData(ctx context.Context, keycloakClient *keycloak.KeycloakClient, data *schema.ResourceData) *keycloak.RealmUserProfileAttribute {
+	attributeData := make(map[string]interface{})
+	for key := range realmUserProfileAttributeSchema() {
+		attributeData[key] = data.Get(key)
+	}
+
+	attribute := getRealmUserProfileAttributeFromData(attributeData)
+
+	if ok, _ := keycloakClient.VersionIsLessThan(ctx, minKeycloakDefaultValueVersion); ok {
+		attribute.DefaultValue = ""
+	}
+
+	return attribute
+}
+
+func setRealmUserProfileAttributeResourceData(data *schema.ResourceData, realmId string, attribute *keycloak.RealmUserProfileAttribute) {
+	data.SetId(fmt.Sprintf("%s/%s", realmId, attribute.Name))
+	data.Set("realm_id", realmId)
+
+	attributeData := getRealmUserProfileAttributeData(attribute)
+	for key := range realmUserProfileAttributeSchema() {
+		data.Set(key, attributeData[key])
+	}
+}
+
+func resourceKeycloakRealmUserProfileAttributeCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	attribute := getRealmUserProfileAttributeFromResourceData(ctx, keycloakClient, data)
+
+	keycloakClient.Mutex.Lock(realmUserProfileMutexKey(realmId))
+	defer keycloakClient.Mutex.Unlock(realmUserProfileMutexKey(realmId))
+
+	// the attribute must not exist yet, otherwise this resource would silently take over an attribute owned by someone else
+	_, err := keycloakClient.GetRealmUserProfileAttribute(ctx, realmId, attribute.Name)
+	if err == nil {
+		return diag.Errorf("user profile attribute %s already exists in realm %s, import it to manage it with terraform", attribute.Name, realmId)
+	}
+	if !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateRealmUserProfileAttribute(ctx, realmId, attribute)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, attribute.Name))
+
+	return resourceKeycloakRealmUserProfileAttributeRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileAttributeRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	attribute, err := keycloakClient.GetRealmUserProfileAttribute(ctx, realmId, data.Get("name").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmUserProfileAttributeResourceData(data, realmId, attribute)
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileAttributeUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	attribute := getRealmUserProfileAttributeFromResourceData(ctx, keycloakClient, data)
+
+	keycloakClient.Mutex.Lock(realmUserProfileMutexKey(realmId))
+	defer keycloakClient.Mutex.Unlock(realmUserProfileMutexKey(realmId))
+
+	err := keycloakClient.UpdateRealmUserProfileAttribute(ctx, realmId, attribute)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmUserProfileAttributeRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileAttributeDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	keycloakClient.Mutex.Lock(realmUserProfileMutexKey(realmId))
+	defer keycloakClient.Mutex.Unlock(realmUserProfileMutexKey(realmId))
+
+	return diag.FromErr(keycloakClient.DeleteRealmUserProfileAttribute(ctx, realmId, data.Get("name").(string)))
+}
+
+// resourceKeycloakRealmUserProfileEntryImport imports attributes and groups of the user profile, both are identified
+// by their realm and name
+func resourceKeycloakRealmUserProfileEntryImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realmId}}/{{name}}")
+	}
+
+	d.Set("realm_id", parts[0])
+	d.Set("name", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
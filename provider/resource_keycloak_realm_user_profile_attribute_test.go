@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmUserProfileAttribute_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmUserProfileAttributeDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfileAttribute_basic(realmName, "Department"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmUserProfileAttributeExists("keycloak_realm_user_profile_attribute.department"),
+					testAccCheckKeycloakRealmUserProfileAttributeExists("keycloak_realm_user_profile_attribute.employee_id"),
+					// the default attributes of the user profile are kept
+					testAccCheckKeycloakRealmUserProfileHasAttributes(realmName, "username", "email", "firstName", "lastName"),
+					resource.TestCheckResourceAttr("keycloak_realm_user_profile_attribute.department", "display_name", "Department"),
+				),
+			},
+			{
+				Config: testKeycloakRealmUserProfileAttribute_basic(realmName, "Business unit"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_user_profile_attribute.department", "display_name", "Business unit"),
+					testAccCheckKeycloakRealmUserProfileHasAttributes(realmName, "username", "email", "employee_id"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_user_profile_attribute.department",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/department", realmName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmUserProfileAttributeExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		_, err := keycloakClient.GetRealmUserProfileAttribute(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["name"])
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakRealmUserProfileHasAttributes(realm string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, name := range names {
+			_, err := keycloakClient.GetRealmUserProfileAttribute(testCtx, realm, name)
+			if err != nil {
+				return fmt.Errorf("expected user profile of realm %s to have attribute %s: %v", realm, name, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmUserProfileAttributeDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_user_profile_attribute" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm_id"]
+			name := rs.Primary.Attributes["name"]
+
+			attribute, _ := keycloakClient.GetRealmUserProfileAttribute(testCtx, realm, name)
+			if attribute != nil {
+				return fmt.Errorf("user profile attribute %s still exists in realm %s", name, realm)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmUserProfileAttribute_basic(realm, departmentDisplayName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_user_profile_group" "employment" {
+	realm_id       = keycloak_realm.realm.id
+	name           = "employment"
+	display_header = "Employment"
+}
+
+resource "keycloak_realm_user_profile_attribute" "department" {
+	realm_id     = keycloak_realm.realm.id
+	name         = "department"
+	display_name = "%s"
+	group        = keycloak_realm_user_profile_group.employment.name
+
+	permissions {
+		view = ["admin", "user"]
+		edit = ["admin"]
+	}
+
+	validator {
+		name = "length"
+		config = {
+			max = "64"
+		}
+	}
+}
+
+resource "keycloak_realm_user_profile_attribute" "employee_id" {
+	realm_id           = keycloak_realm.realm.id
+	name               = "employee_id"
+	group              = keycloak_realm_user_profile_group.employment.name
+	required_for_roles = ["user"]
+
+	permissions {
+		view = ["admin"]
+		edit = ["admin"]
+	}
+}
+	`, realm, departmentDisplayName)
+}
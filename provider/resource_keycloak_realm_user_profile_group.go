@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// keycloak_realm_user_profile_group manages a single group of the user profile. Unlike keycloak_realm_user_profile,
+// it leaves all other attributes and groups of the user profile untouched.
+func resourceKeycloakRealmUserProfileGroup() *schema.Resource {
+	groupSchema := realmUserProfileGroupSchema()
+	groupSchema["name"].ForceNew = true
+	groupSchema["realm_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmUserProfileGroupCreate,
+		ReadContext:   resourceKeycloakRealmUserProfileGroupRead,
+		UpdateContext: resourceKeycloakRealmUserProfileGroupUpdate,
+		DeleteContext: resourceKeycloakRealmUserProfileGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmUserProfileEntryImport,
+		},
+		Schema: groupSchema,
+	}
+}
+
+func getRealmUserProfileGroupFromResourceData(data *schema.ResourceData) *keycloak.RealmUserProfileGroup {
+	groupData := make(map[string]interface{})
+	for key := range realmUserProfileGroupSchema() {
+		groupData[key] = data.Get(key)
+	}
+
+	return getRealmUserProfileGroupFromData(groupData)
+}
+
+func setRealmUserProfileGroupResourceData(data *schema.ResourceData, realmId string, group *keycloak.RealmUserProfileGroup) {
+	data.SetId(fmt.Sprintf("%s/%s", realmId, group.Name))
+	data.Set("realm_id", realmId)
+
+	groupData := getRealmUserProfileGroupData(group)
+	for key := range realmUserProfileGroupSchema() {
+		data.Set(key, groupData[key])
+	}
+}
+
+func resourceKeycloakRealmUserProfileGroupCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	group := getRealmUserProfileGroupFromResourceData(data)
+
+	keycloakClient.Mutex.Lock(realmUserProfileMutexKey(realmId))
+	defer keycloakClient.Mutex.Unlock(realmUserProfileMutexKey(realmId))
+
+	// the group must not exist yet, otherwise this resource would silently take over a group owned by someone else
+	_, err := keycloakClient.GetRealmUserProfileGroup(ctx, realmId, group.Name)
+	if err == nil {
+		return diag.Errorf("user profile group %s already exists in realm %s, import it to manage it with terraform", group.Name, realmId)
+	}
+	if !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	err = keycloakClient.UpdateRealmUserProfileGroup(ctx, realmId, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, group.Name))
+
+	return resourceKeycloakRealmUserProfileGroupRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileGroupRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	group, err := keycloakClient.GetRealmUserProfileGroup(ctx, realmId, data.Get("name").(string))
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	setRealmUserProfileGroupResourceData(data, realmId, group)
+
+	return nil
+}
+
+func resourceKeycloakRealmUserProfileGroupUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	group := getRealmUserProfileGroupFromResourceData(data)
+
+	keycloakClient.Mutex.Lock(realmUserProfileMutexKey(realmId))
+	defer keycloakClient.Mutex.Unlock(realmUserProfileMutexKey(realmId))
+
+	err := keycloakClient.UpdateRealmUserProfileGroup(ctx, realmId, group)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakRealmUserProfileGroupRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmUserProfileGroupDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	keycloakClient.Mutex.Lock(realmUserProfileMutexKey(realmId))
+	defer keycloakClient.Mutex.Unlock(realmUserProfileMutexKey(realmId))
+
+	return diag.FromErr(keycloakClient.DeleteRealmUserProfileGroup(ctx, realmId, data.Get("name").(string)))
+}
@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakRealmUserProfileGroup_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmUserProfileGroupDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmUserProfileGroup_basic(realmName, "Employment"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRealmUserProfileGroupExists("keycloak_realm_user_profile_group.employment"),
+					testAccCheckKeycloakRealmUserProfileGroupExists("keycloak_realm_user_profile_group.contact"),
+					// the default group of the user profile is kept
+					testAccCheckKeycloakRealmUserProfileHasGroups(realmName, "user-metadata"),
+				),
+			},
+			{
+				Config: testKeycloakRealmUserProfileGroup_basic(realmName, "Employment details"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_user_profile_group.employment", "display_header", "Employment details"),
+					testAccCheckKeycloakRealmUserProfileHasGroups(realmName, "user-metadata", "contact"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_user_profile_group.employment",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/employment", realmName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakRealmUserProfileGroupExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		_, err := keycloakClient.GetRealmUserProfileGroup(testCtx, rs.Primary.Attributes["realm_id"], rs.Primary.Attributes["name"])
+
+		return err
+	}
+}
+
+func testAccCheckKeycloakRealmUserProfileHasGroups(realm string, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, name := range names {
+			_, err := keycloakClient.GetRealmUserProfileGroup(testCtx, realm, name)
+			if err != nil {
+				return fmt.Errorf("expected user profile of realm %s to have group %s: %v", realm, name, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakRealmUserProfileGroupDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_realm_user_profile_group" {
+				continue
+			}
+
+			realm := rs.Primary.Attributes["realm_id"]
+			name := rs.Primary.Attributes["name"]
+
+			group, _ := keycloakClient.GetRealmUserProfileGroup(testCtx, realm, name)
+			if group != nil {
+				return fmt.Errorf("user profile group %s still exists in realm %s", name, realm)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakRealmUserProfileGroup_basic(realm, displayHeader string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_user_profile_group" "employment" {
+	realm_id            = keycloak_realm.realm.id
+	name                = "employment"
+	display_header      = "%s"
+	display_description = "Information about the employment of the user"
+
+	annotations = {
+		foo = "bar"
+	}
+}
+
+resource "keycloak_realm_user_profile_group" "contact" {
+	realm_id       = keycloak_realm.realm.id
+	name           = "contact"
+	display_header = "Contact"
+}
+	`, realm, displayHeader)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakRealmWebAuthnPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakRealmWebAuthnPolicyCreate,
+		ReadContext:   resourceKeycloakRealmWebAuthnPolicyRead,
+		UpdateContext: resourceKeycloakRealmWebAuthnPolicyUpdate,
+		DeleteContext: resourceKeycloakRealmSettingsDelete,
+		// This resource can be imported using {{realm}}
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakRealmSettingsImport,
+		},
+		Schema: realmSettingsSchema(realmWebAuthnPolicyKeys...),
+	}
+}
+
+func resourceKeycloakRealmWebAuthnPolicyApply(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+
+	keycloakVersion, err := keycloakClient.Version(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateRealmSettings(ctx, keycloakClient, realmId, func(realm *keycloak.Realm) error {
+		return getRealmWebAuthnPoliciesFromData(data, realm, keycloakVersion)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(realmId)
+
+	return nil
+}
+
+func resourceKeycloakRealmWebAuthnPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmWebAuthnPolicyApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmWebAuthnPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakRealmWebAuthnPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	keycloakVersion, err := keycloakClient.Version(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	realmId := data.Id()
+
+	realm, err := keycloakClient.GetRealm(ctx, realmId)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	data.Set("realm_id", realmId)
+	setRealmWebAuthnPoliciesData(data, realm, keycloakVersion)
+
+	return nil
+}
+
+func resourceKeycloakRealmWebAuthnPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceKeycloakRealmWebAuthnPolicyApply(ctx, data, meta)
+	if diags.HasError() {
+		return diags
+	}
+
+	return resourceKeycloakRealmWebAuthnPolicyRead(ctx, data, meta)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakRealmWebAuthnPolicy_basic(t *testing.T) {
+	t.Parallel()
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakRealmDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRealmWebAuthnPolicy_basic(realmName, "example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_realm_webauthn_policy.webauthn", "web_authn_policy.0.relying_party_entity_name", "example.com"),
+					resource.TestCheckResourceAttr("keycloak_realm_webauthn_policy.webauthn", "web_authn_passwordless_policy.0.user_verification_requirement", "required"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_realm_webauthn_policy.webauthn",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testKeycloakRealmWebAuthnPolicy_basic(realmName, "example.org"),
+				Check:  resource.TestCheckResourceAttr("keycloak_realm_webauthn_policy.webauthn", "web_authn_policy.0.relying_party_entity_name", "example.org"),
+			},
+		},
+	})
+}
+
+func testKeycloakRealmWebAuthnPolicy_basic(realm, relyingPartyEntityName string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+
+	lifecycle {
+		ignore_changes = [web_authn_policy, web_authn_passwordless_policy]
+	}
+}
+
+resource "keycloak_realm_webauthn_policy" "webauthn" {
+	realm_id = keycloak_realm.realm.id
+
+	web_authn_policy {
+		relying_party_entity_name = "%s"
+		signature_algorithms      = ["ES256", "RS256"]
+	}
+
+	web_authn_passwordless_policy {
+		user_verification_requirement = "required"
+	}
+}
+	`, realm, relyingPartyEntityName)
+}
@@ -114,6 +114,13 @@ func resourceKeycloakRequiredActionsCreate(ctx context.Context, data *schema.Res
 		return diag.FromErr(err)
 	}
 
+	if len(action.Config) != 0 {
+		err = updateRequiredActionConfig(ctx, keycloakClient, action)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	setRequiredActionData(data, action)
 
 	return resourceKeycloakRequiredActionsRead(ctx, data, meta)
@@ -127,11 +134,51 @@ func resourceKeycloakRequiredActionsRead(ctx context.Context, data *schema.Resou
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	// most required actions aren't configurable, so the config is only read when there is one to compare against
+	if len(data.Get("config").(map[string]interface{})) != 0 {
+		action.Config, err = getRequiredActionConfig(ctx, keycloakClient, action)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	setRequiredActionData(data, action)
 
 	return nil
 }
 
+func getRequiredActionConfig(ctx context.Context, keycloakClient *keycloak.KeycloakClient, action *keycloak.RequiredAction) (map[string]string, error) {
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_25)
+	if err != nil {
+		return nil, err
+	}
+
+	if !versionOk {
+		return action.Config, nil
+	}
+
+	return keycloakClient.GetRequiredActionConfig(ctx, action.RealmId, action.Alias)
+}
+
+// Since Keycloak 25 the configuration of a required action is no longer part of its representation and has to be
+// written through the dedicated config endpoint. Older versions still accept it inline.
+func updateRequiredActionConfig(ctx context.Context, keycloakClient *keycloak.KeycloakClient, action *keycloak.RequiredAction) error {
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_25)
+	if err != nil {
+		return err
+	}
+
+	if !versionOk {
+		return nil
+	}
+
+	if len(action.Config) == 0 {
+		return keycloakClient.DeleteRequiredActionConfig(ctx, action.RealmId, action.Alias)
+	}
+
+	return keycloakClient.UpdateRequiredActionConfig(ctx, action.RealmId, action.Alias, action.Config)
+}
+
 func resourceKeycloakRequiredActionsUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	keycloakClient := meta.(*keycloak.KeycloakClient)
 
@@ -145,6 +192,13 @@ func resourceKeycloakRequiredActionsUpdate(ctx context.Context, data *schema.Res
 		return diag.FromErr(err)
 	}
 
+	if data.HasChange("config") {
+		err = updateRequiredActionConfig(ctx, keycloakClient, action)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	setRequiredActionData(data, action)
 
 	return nil
@@ -167,13 +221,19 @@ func resourceKeycloakRequiredActionsImport(ctx context.Context, d *schema.Resour
 		return nil, fmt.Errorf("invalid import. Supported import formats: {{realmId}}/{{alias}}")
 	}
 
-	_, err := keycloakClient.GetRequiredAction(ctx, parts[0], parts[1])
+	action, err := keycloakClient.GetRequiredAction(ctx, parts[0], parts[1])
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := getRequiredActionConfig(ctx, keycloakClient, action)
 	if err != nil {
 		return nil, err
 	}
 
 	d.Set("realm_id", parts[0])
 	d.Set("alias", parts[1])
+	d.Set("config", config)
 	d.SetId(fmt.Sprintf("%s/%s", parts[0], parts[1]))
 
 	diagnostics := resourceKeycloakRequiredActionsRead(ctx, d, meta)
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
 func TestAccKeycloakRequiredAction_basic(t *testing.T) {
@@ -48,6 +49,87 @@ func TestAccKeycloakRequiredAction_withConfig(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakRequiredAction_updateConfig(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_25)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	requiredActionAlias := "UPDATE_PASSWORD"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRequiredAction_withConfig(realmName, requiredActionAlias, 37, "600"),
+				Check:  testAccCheckKeycloakRequiredActionHasConfig(realmName, requiredActionAlias, "max_auth_age", "600"),
+			},
+			{
+				Config: testKeycloakRequiredAction_withConfig(realmName, requiredActionAlias, 37, "1200"),
+				Check:  testAccCheckKeycloakRequiredActionHasConfig(realmName, requiredActionAlias, "max_auth_age", "1200"),
+			},
+			{
+				Config: testKeycloakRequiredAction_basic(realmName, requiredActionAlias, 37),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRequiredActionHasConfig(realmName, requiredActionAlias, "max_auth_age", ""),
+					resource.TestCheckResourceAttr("keycloak_required_action.required_action", "config.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRequiredAction_invalidConfigKey(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_25)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testKeycloakRequiredAction_withConfigKey(realmName, "UPDATE_PASSWORD", "max_auth_ages", "600"),
+				ExpectError: regexp.MustCompile("validation error: config key \"max_auth_ages\" is not supported by required action \"UPDATE_PASSWORD\""),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakRequiredAction_notConfigurable(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_25)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	requiredActionAlias := "TERMS_AND_CONDITIONS"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakRequiredAction_basic(realmName, requiredActionAlias, 37),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakRequiresActionExistsWithCorrectPriority(realmName, requiredActionAlias, 37),
+					resource.TestCheckResourceAttr("keycloak_required_action.required_action", "config.%", "0"),
+				),
+			},
+			{
+				Config: testKeycloakRequiredAction_basic(realmName, requiredActionAlias, 38),
+				Check:  testAccCheckKeycloakRequiresActionExistsWithCorrectPriority(realmName, requiredActionAlias, 38),
+			},
+			{
+				ResourceName:      "keycloak_required_action.required_action",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateId:     realmName + "/" + requiredActionAlias,
+			},
+			{
+				Config:      testKeycloakRequiredAction_withConfigKey(realmName, requiredActionAlias, "max_auth_age", "600"),
+				ExpectError: regexp.MustCompile("validation error: required action \"TERMS_AND_CONDITIONS\" does not support configuration"),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakRequiredAction_unregisteredAction(t *testing.T) {
 	realmName := acctest.RandomWithPrefix("tf-acc")
 	requiredActionAlias := "webauthn-register"
@@ -169,6 +251,23 @@ resource "keycloak_required_action" "required_action" {
 	`, realm, requiredActionAlias, priority, maxAuthAgeConfig)
 }
 
+func testKeycloakRequiredAction_withConfigKey(realm, requiredActionAlias, key, value string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_required_action" "required_action" {
+	realm_id		= keycloak_realm.realm.realm
+	alias			= "%s"
+	enabled			= true
+	config = {
+		%s = "%s"
+	}
+}
+	`, realm, requiredActionAlias, key, value)
+}
+
 func testKeycloakRequiredAction_import(realm, requiredActionAlias string) string {
 	return fmt.Sprintf(`
 resource "keycloak_realm" "realm" {
@@ -246,3 +345,18 @@ func testAccCheckKeycloakRequiresActionExists(realm, requiredActionAlias string)
 		return nil
 	}
 }
+
+func testAccCheckKeycloakRequiredActionHasConfig(realm, requiredActionAlias, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config, err := keycloakClient.GetRequiredActionConfig(testCtx, realm, requiredActionAlias)
+		if err != nil {
+			return fmt.Errorf("error getting config of required action %s: %s", requiredActionAlias, err)
+		}
+
+		if config[key] != value {
+			return fmt.Errorf("expected required action %s to have config %s with value %q, but got %q", requiredActionAlias, key, value, config[key])
+		}
+
+		return nil
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"dario.cat/mergo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/keycloak/terraform-provider-keycloak/keycloak"
 )
 
@@ -20,6 +21,7 @@ func resourceKeycloakUser() *schema.Resource {
 		ReadContext:   resourceKeycloakUserRead,
 		DeleteContext: resourceKeycloakUserDelete,
 		UpdateContext: resourceKeycloakUserUpdate,
+		CustomizeDiff: resourceKeycloakUserDiff,
 		// This resource can be imported using {{realm}}/({{user_id}}|{{user_name}}). The User's ID is displayed in the GUI when editing
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceKeycloakUserImport,
@@ -112,6 +114,93 @@ func resourceKeycloakUser() *schema.Resource {
 					},
 				},
 			},
+			"initial_password_hash": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: onlyDiffOnCreate,
+				MaxItems:         1,
+				ConflictsWith:    []string{"initial_password"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"algorithm": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The id of the password hashing provider that can verify the hash, e.g. pbkdf2-sha256",
+						},
+						"hash": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"salt": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsBase64,
+						},
+						"iterations": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"additional_parameters": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"temporary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"initial_otp": {
+				Type:             schema.TypeList,
+				Optional:         true,
+				DiffSuppressFunc: onlyDiffOnCreate,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"secret": {
+							Type:      schema.TypeString,
+							Required:  true,
+							Sensitive: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "totp",
+							ValidateFunc: validation.StringInSlice(keycloakRealmValidOTPTypes, false),
+						},
+						"algorithm": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "HmacSHA1",
+							ValidateFunc: validation.StringInSlice(keycloakRealmValidOTPAlgorithms, false),
+						},
+						"digits": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  6,
+						},
+						"period": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  30,
+						},
+						"counter": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -131,6 +220,91 @@ func onlyDiffOnCreate(_, _, _ string, d *schema.ResourceData) bool {
 	return d.Id() != ""
 }
 
+// resourceKeycloakUserDiff validates the user against the user profile of the realm, so that values Keycloak would
+// reject or drop are reported by the plan instead of failing the apply or causing perpetual diffs
+func resourceKeycloakUserDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// imported users are merged with the existing user, so the configuration doesn't have to be complete
+	if diff.Get("import").(bool) {
+		return nil
+	}
+
+	if diff.Id() != "" && !diff.HasChanges("email", "first_name", "last_name", "attributes") {
+		return nil
+	}
+
+	for _, key := range []string{"realm_id", "email", "first_name", "last_name", "attributes"} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmUserProfile, err := getRealmUserProfileForValidation(ctx, keycloakClient, diff.Get("realm_id").(string))
+	if err != nil || realmUserProfile == nil {
+		return err
+	}
+
+	attributes := map[string][]string{}
+	for key, value := range diff.Get("attributes").(map[string]interface{}) {
+		attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+	}
+
+	user := &keycloak.User{
+		Email:      diff.Get("email").(string),
+		FirstName:  diff.Get("first_name").(string),
+		LastName:   diff.Get("last_name").(string),
+		Attributes: attributes,
+	}
+
+	return realmUserProfile.ValidateUser(user)
+}
+
+// getRealmUserProfileForValidation returns nil when the user profile can't be used to validate users, because the
+// realm doesn't exist yet or because the user profile is not always enabled by this version of Keycloak
+func getRealmUserProfileForValidation(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string) (*keycloak.RealmUserProfile, error) {
+	versionOk, err := keycloakClient.VersionIsGreaterThanOrEqualTo(ctx, keycloak.Version_24)
+	if err != nil || !versionOk {
+		return nil, err
+	}
+
+	realmUserProfile, err := keycloakClient.GetRealmUserProfile(ctx, realmId)
+	if err != nil {
+		if keycloak.ErrorIs404(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return realmUserProfile, nil
+}
+
+// userProfileUnmanagedAttributesWarning warns about the attributes of the user that Keycloak discards because they are
+// not part of the user profile, as they show up as a diff on every plan
+func userProfileUnmanagedAttributesWarning(ctx context.Context, keycloakClient *keycloak.KeycloakClient, user *keycloak.User) diag.Diagnostics {
+	if len(user.Attributes) == 0 {
+		return nil
+	}
+
+	realmUserProfile, err := getRealmUserProfileForValidation(ctx, keycloakClient, user.RealmId)
+	if err != nil || realmUserProfile == nil || !realmUserProfile.UnmanagedAttributesDiscarded() {
+		return nil
+	}
+
+	unmanagedAttributes := realmUserProfile.UnmanagedAttributes(user)
+	if len(unmanagedAttributes) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Unmanaged attributes are discarded",
+			Detail:   fmt.Sprintf("The attributes %s of user %s are not part of the user profile of realm %s, and are discarded by Keycloak because its unmanaged attribute policy doesn't let administrators edit them. Add them to the user profile or change the unmanaged attribute policy to ADMIN_EDIT or ENABLED.", strings.Join(unmanagedAttributes, ", "), user.Username, user.RealmId),
+		},
+	}
+}
+
 func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
 	attributes := map[string][]string{}
 	var requiredActions []string
@@ -146,10 +320,14 @@ func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
 		}
 	}
 
-	federatedIdentities := &keycloak.FederatedIdentities{}
+	// leaving the federated identities nil keeps the links of the user untouched, unless the last federated_identity
+	// block was just removed, in which case the empty list unlinks the user
+	var federatedIdentities keycloak.FederatedIdentities
 
 	if v, ok := data.GetOk("federated_identity"); ok {
-		federatedIdentities = getUserFederatedIdentitiesFromData(v.(*schema.Set).List())
+		federatedIdentities = *getUserFederatedIdentitiesFromData(v.(*schema.Set).List())
+	} else if data.HasChange("federated_identity") && !data.IsNewResource() {
+		federatedIdentities = keycloak.FederatedIdentities{}
 	}
 
 	return &keycloak.User{
@@ -162,7 +340,7 @@ func mapFromDataToUser(data *schema.ResourceData) *keycloak.User {
 		LastName:            data.Get("last_name").(string),
 		Enabled:             data.Get("enabled").(bool),
 		Attributes:          attributes,
-		FederatedIdentities: *federatedIdentities,
+		FederatedIdentities: federatedIdentities,
 		RequiredActions:     requiredActions,
 	}
 }
@@ -181,6 +359,55 @@ func getUserFederatedIdentitiesFromData(data []interface{}) *keycloak.FederatedI
 	return &federatedIdentities
 }
 
+// getUserCredentialsFromData returns the credentials that are imported along with the user when it is created
+func getUserCredentialsFromData(data *schema.ResourceData) ([]*keycloak.UserCredential, error) {
+	var credentials []*keycloak.UserCredential
+
+	if v, ok := data.GetOk("initial_password_hash"); ok {
+		passwordHash := v.([]interface{})[0].(map[string]interface{})
+
+		additionalParameters := map[string][]string{}
+		for key, value := range passwordHash["additional_parameters"].(map[string]interface{}) {
+			additionalParameters[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		credential, err := keycloak.NewPasswordHashCredential(
+			passwordHash["algorithm"].(string),
+			passwordHash["iterations"].(int),
+			passwordHash["hash"].(string),
+			passwordHash["salt"].(string),
+			additionalParameters,
+			passwordHash["temporary"].(bool),
+		)
+		if err != nil {
+			return nil, err
+		}
+		credentials = append(credentials, credential)
+	}
+
+	if v, ok := data.GetOk("initial_otp"); ok {
+		for _, otpData := range v.([]interface{}) {
+			otp := otpData.(map[string]interface{})
+
+			credential, err := keycloak.NewOtpCredential(
+				otp["type"].(string),
+				otp["algorithm"].(string),
+				otp["digits"].(int),
+				otp["period"].(int),
+				otp["counter"].(int),
+				otp["secret"].(string),
+				otp["label"].(string),
+			)
+			if err != nil {
+				return nil, err
+			}
+			credentials = append(credentials, credential)
+		}
+	}
+
+	return credentials, nil
+}
+
 func mapFromUserToData(data *schema.ResourceData, user *keycloak.User) {
 	var federatedIdentities []interface{}
 	for _, federatedIdentity := range user.FederatedIdentities {
@@ -214,7 +441,18 @@ func resourceKeycloakUserCreate(ctx context.Context, data *schema.ResourceData,
 	user := mapFromDataToUser(data)
 
 	if !data.Get("import").(bool) {
-		err := keycloakClient.NewUser(ctx, user)
+		credentials, err := getUserCredentialsFromData(data)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = keycloakClient.ValidateUserCredentials(ctx, credentials)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		user.Credentials = credentials
+
+		err = keycloakClient.NewUser(ctx, user)
 		if err != nil {
 			return diag.FromErr(err)
 		}
@@ -239,9 +477,13 @@ func resourceKeycloakUserCreate(ctx context.Context, data *schema.ResourceData,
 			return diag.FromErr(fmt.Errorf("no user found for username %s", username))
 		}
 
+		// the links of the existing user are only managed when federated_identity blocks are configured
+		federatedIdentities := user.FederatedIdentities
 		if err = mergo.Merge(user, existingUser); err != nil {
 			return diag.FromErr(err)
 		}
+		user.FederatedIdentities = federatedIdentities
+
 		err = keycloakClient.UpdateUser(ctx, user)
 		if err != nil {
 			return diag.FromErr(err)
@@ -250,7 +492,9 @@ func resourceKeycloakUserCreate(ctx context.Context, data *schema.ResourceData,
 
 	mapFromUserToData(data, user)
 
-	return resourceKeycloakUserRead(ctx, data, meta)
+	diags := userProfileUnmanagedAttributesWarning(ctx, keycloakClient, user)
+
+	return append(diags, resourceKeycloakUserRead(ctx, data, meta)...)
 }
 
 func resourceKeycloakUserRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -264,6 +508,13 @@ func resourceKeycloakUserRead(ctx context.Context, data *schema.ResourceData, me
 		return handleNotFoundError(ctx, err, data)
 	}
 
+	// Links to identity providers can also be managed with keycloak_user_federated_identity or be created when logging
+	// in, so they are only tracked once federated_identity blocks have been applied. Otherwise they would show up as
+	// changes that unlink the user.
+	if data.Get("federated_identity").(*schema.Set).Len() == 0 {
+		user.FederatedIdentities = nil
+	}
+
 	mapFromUserToData(data, user)
 
 	if _, ok := data.GetOk("import"); !ok {
@@ -285,7 +536,7 @@ func resourceKeycloakUserUpdate(ctx context.Context, data *schema.ResourceData,
 
 	mapFromUserToData(data, user)
 
-	return nil
+	return userProfileUnmanagedAttributesWarning(ctx, keycloakClient, user)
 }
 
 func resourceKeycloakUserDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakUserConsentRevocation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserConsentRevocationCreate,
+		ReadContext:   resourceKeycloakUserConsentRevocationRead,
+		DeleteContext: resourceKeycloakUserConsentRevocationDelete,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"client_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+				Set:         schema.HashString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The client_id of the clients to revoke the consent and offline tokens of.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will revoke the consents again.",
+			},
+			"revoked_client_ids": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Computed:    true,
+				Description: "The client_id of the clients the user had given consent to or held offline tokens for when they were revoked.",
+			},
+		},
+	}
+}
+
+func resourceKeycloakUserConsentRevocationCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	clientIds := interfaceSliceToStringSlice(data.Get("client_ids").(*schema.Set).List())
+
+	consents, err := keycloakClient.GetUserConsents(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Keycloak returns a 404 when revoking the consent to a client the user never gave consent to
+	var revokedClientIds []string
+	for _, consent := range consents {
+		if !slices.Contains(clientIds, consent.ClientId) {
+			continue
+		}
+
+		err = keycloakClient.RevokeUserConsent(ctx, realmId, userId, consent.ClientId)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return diag.FromErr(err)
+		}
+
+		revokedClientIds = append(revokedClientIds, consent.ClientId)
+	}
+
+	data.SetId(fmt.Sprintf("%s/%s", realmId, userId))
+	data.Set("revoked_client_ids", revokedClientIds)
+
+	return resourceKeycloakUserConsentRevocationRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserConsentRevocationRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	// the revocation is a one-off action, there is nothing to read besides checking that the user still exists
+	if _, err := keycloakClient.GetUser(ctx, realmId, userId); err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	return nil
+}
+
+// Revoked consents can't be restored, so deleting this resource only removes it from the state
+func resourceKeycloakUserConsentRevocationDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
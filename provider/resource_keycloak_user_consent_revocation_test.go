@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakUserConsentRevocation_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+	password := "My password"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserConsentRevocation_basic(username, password, clientId, false),
+				Check:  testAccKeycloakUserOfflineLogin(username, password, clientId),
+			},
+			{
+				Config: testKeycloakUserConsentRevocation_basic(username, password, clientId, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keycloak_user_consents.consents", "consents.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_user_consents.consents", "consents.0.client_id", clientId),
+					resource.TestCheckResourceAttr("data.keycloak_user_consents.consents", "consents.0.offline_token", "true"),
+				),
+			},
+			{
+				Config: testKeycloakUserConsentRevocation_basic(username, password, clientId, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_consent_revocation.revocation", "revoked_client_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("keycloak_user_consent_revocation.revocation", "revoked_client_ids.*", clientId),
+					resource.TestCheckResourceAttr("data.keycloak_user_consents.consents", "consents.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// testAccKeycloakUserOfflineLogin logs in as the user and requests an offline token, which Keycloak lists as a consent
+func testAccKeycloakUserOfflineLogin(username, password, clientId string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resourceUrl := fmt.Sprintf("%s/realms/%s/protocol/openid-connect/token", os.Getenv("KEYCLOAK_URL"), testAccRealm.Realm)
+
+		form := url.Values{}
+		form.Add("username", username)
+		form.Add("password", password)
+		form.Add("client_id", clientId)
+		form.Add("grant_type", "password")
+		form.Add("scope", "openid offline_access")
+
+		request, err := http.NewRequest(http.MethodPost, resourceUrl, strings.NewReader(form.Encode()))
+		if err != nil {
+			return err
+		}
+		request.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+
+		response, err := keycloakClient.GetHttpClient().Do(request)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(response.Body)
+			return fmt.Errorf("user with username %s cannot get an offline token\n body: %s", username, string(body))
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserConsentRevocation_basic(username, password, clientId string, revoke bool) string {
+	revocation := ""
+	if revoke {
+		revocation = `
+resource "keycloak_user_consent_revocation" "revocation" {
+	realm_id   = data.keycloak_realm.realm.id
+	user_id    = keycloak_user.user.id
+	client_ids = [keycloak_openid_client.client.client_id]
+}
+`
+	}
+
+	dependsOn := "keycloak_user.user"
+	if revoke {
+		dependsOn = "keycloak_user_consent_revocation.revocation"
+	}
+
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_openid_client" "client" {
+	realm_id                     = data.keycloak_realm.realm.id
+	client_id                    = "%s"
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	initial_password {
+		value     = "%s"
+		temporary = false
+	}
+}
+%s
+data "keycloak_user_consents" "consents" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+
+	depends_on = [
+		keycloak_openid_client.client,
+		%s,
+	]
+}
+	`, testAccRealm.Realm, clientId, username, password, revocation, dependsOn)
+}
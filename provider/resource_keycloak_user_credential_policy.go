@@ -0,0 +1,306 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakUserCredentialPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserCredentialPolicyCreate,
+		ReadContext:   resourceKeycloakUserCredentialPolicyRead,
+		UpdateContext: resourceKeycloakUserCredentialPolicyUpdate,
+		DeleteContext: resourceKeycloakUserCredentialPolicyDelete,
+		// This resource can be imported using {{realm}}/{{userId}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakUserCredentialPolicyImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"remove_credential_types": {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+				Set:         schema.HashString,
+				Optional:    true,
+				Description: "All credentials of these types are removed from the user when the policy is created or when this attribute changes.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will run the credential removal again.",
+			},
+			"credential": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Credentials of the user, ordered by priority. The first credential is the one used by default.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"user_label": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func userCredentialPolicyId(realmId, userId string) string {
+	return fmt.Sprintf("%s/%s", realmId, userId)
+}
+
+type userCredentialPolicyEntry struct {
+	Id        string
+	UserLabel string
+}
+
+func getUserCredentialPolicyEntriesFromData(data *schema.ResourceData) []userCredentialPolicyEntry {
+	var entries []userCredentialPolicyEntry
+	for _, c := range data.Get("credential").([]interface{}) {
+		credential := c.(map[string]interface{})
+		entries = append(entries, userCredentialPolicyEntry{
+			Id:        credential["id"].(string),
+			UserLabel: credential["user_label"].(string),
+		})
+	}
+
+	return entries
+}
+
+func sortUserCredentialsByPriority(credentials []*keycloak.UserCredential) []*keycloak.UserCredential {
+	sorted := slices.Clone(credentials)
+	slices.SortStableFunc(sorted, func(a, b *keycloak.UserCredential) int {
+		return a.Priority - b.Priority
+	})
+
+	return sorted
+}
+
+func removeUserCredentialsOfTypes(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, userId string, credentialTypes []string) error {
+	if len(credentialTypes) == 0 {
+		return nil
+	}
+
+	credentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	for _, credential := range credentials {
+		if !slices.Contains(credentialTypes, credential.Type) {
+			continue
+		}
+
+		err = keycloakClient.DeleteUserCredential(ctx, realmId, userId, credential.Id)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func applyUserCredentialPolicyEntries(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, userId string, entries []userCredentialPolicyEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	credentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return err
+	}
+
+	credentialsById := make(map[string]*keycloak.UserCredential, len(credentials))
+	for _, credential := range credentials {
+		credentialsById[credential.Id] = credential
+	}
+
+	for i, entry := range entries {
+		credential, ok := credentialsById[entry.Id]
+		if !ok {
+			return fmt.Errorf("credential with id %s does not exist for user %s", entry.Id, userId)
+		}
+
+		if entry.UserLabel != "" && entry.UserLabel != credential.UserLabel {
+			err = keycloakClient.UpdateUserCredentialLabel(ctx, realmId, userId, entry.Id, entry.UserLabel)
+			if err != nil {
+				return err
+			}
+		}
+
+		if i == 0 {
+			err = keycloakClient.MoveUserCredentialToFirst(ctx, realmId, userId, entry.Id)
+		} else {
+			err = keycloakClient.MoveUserCredentialAfter(ctx, realmId, userId, entry.Id, entries[i-1].Id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserCredentialPolicyCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	if _, err := keycloakClient.GetUser(ctx, realmId, userId); err != nil {
+		return diag.FromErr(err)
+	}
+
+	credentialTypes := interfaceSliceToStringSlice(data.Get("remove_credential_types").(*schema.Set).List())
+	err := removeUserCredentialsOfTypes(ctx, keycloakClient, realmId, userId, credentialTypes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = applyUserCredentialPolicyEntries(ctx, keycloakClient, realmId, userId, getUserCredentialPolicyEntriesFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(userCredentialPolicyId(realmId, userId))
+
+	return resourceKeycloakUserCredentialPolicyRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserCredentialPolicyRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	// check if user exists, remove from state if not found
+	if _, err := keycloakClient.GetUser(ctx, realmId, userId); err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	credentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	entries := getUserCredentialPolicyEntriesFromData(data)
+	managedLabels := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		managedLabels[entry.Id] = entry.UserLabel != ""
+	}
+
+	// only the credentials managed by this resource are tracked, in the order of their current priority
+	var credentialsData []map[string]interface{}
+	for _, credential := range sortUserCredentialsByPriority(credentials) {
+		hasLabel, ok := managedLabels[credential.Id]
+		if !ok {
+			continue
+		}
+
+		userLabel := ""
+		if hasLabel {
+			userLabel = credential.UserLabel
+		}
+
+		credentialsData = append(credentialsData, map[string]interface{}{
+			"id":         credential.Id,
+			"user_label": userLabel,
+		})
+	}
+
+	data.Set("credential", credentialsData)
+	data.SetId(userCredentialPolicyId(realmId, userId))
+
+	return nil
+}
+
+func resourceKeycloakUserCredentialPolicyUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+
+	if data.HasChange("remove_credential_types") {
+		credentialTypes := interfaceSliceToStringSlice(data.Get("remove_credential_types").(*schema.Set).List())
+		err := removeUserCredentialsOfTypes(ctx, keycloakClient, realmId, userId, credentialTypes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err := applyUserCredentialPolicyEntries(ctx, keycloakClient, realmId, userId, getUserCredentialPolicyEntriesFromData(data))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceKeycloakUserCredentialPolicyRead(ctx, data, meta)
+}
+
+// Removed credentials can't be restored, so deleting this resource only removes it from the state
+func resourceKeycloakUserCredentialPolicyDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceKeycloakUserCredentialPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{userId}}.")
+	}
+
+	realmId := parts[0]
+	userId := parts[1]
+
+	credentials, err := keycloakClient.GetUserCredentials(ctx, realmId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// an imported policy manages all the credentials the user currently has
+	var credentialsData []map[string]interface{}
+	for _, credential := range sortUserCredentialsByPriority(credentials) {
+		credentialsData = append(credentialsData, map[string]interface{}{
+			"id":         credential.Id,
+			"user_label": credential.UserLabel,
+		})
+	}
+
+	d.Set("realm_id", realmId)
+	d.Set("user_id", userId)
+	d.Set("credential", credentialsData)
+
+	d.SetId(userCredentialPolicyId(realmId, userId))
+
+	diagnostics := resourceKeycloakUserCredentialPolicyRead(ctx, d, meta)
+	if diagnostics.HasError() {
+		return nil, errors.New(diagnostics[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakUserCredentialPolicy_removeCredentialTypes(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserCredentialPolicy_removeCredentialTypes(username),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserCredentialTypes("keycloak_user.user", []string{"password"}),
+					resource.TestCheckResourceAttr("keycloak_user_credential_policy.policy", "credential.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUserCredentialPolicy_priorityAndLabels(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserCredentialPolicy_priorityAndLabels(username, "phone"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserCredentialTypes("keycloak_user.user", []string{"otp", "password"}),
+					resource.TestCheckResourceAttr("keycloak_user_credential_policy.policy", "credential.0.user_label", "phone"),
+					resource.TestCheckResourceAttr("keycloak_user_credential_policy.policy", "credential.1.user_label", ""),
+				),
+			},
+			{
+				Config: testKeycloakUserCredentialPolicy_priorityAndLabels(username, "authenticator"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserCredentialTypes("keycloak_user.user", []string{"otp", "password"}),
+					resource.TestCheckResourceAttr("keycloak_user_credential_policy.policy", "credential.0.user_label", "authenticator"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckKeycloakUserCredentialTypes checks the types of the credentials of a user, in the order of their priority
+func testAccCheckKeycloakUserCredentialTypes(resourceName string, credentialTypes []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		user, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		credentials, err := keycloakClient.GetUserCredentials(testCtx, user.RealmId, user.Id)
+		if err != nil {
+			return err
+		}
+
+		var types []string
+		for _, credential := range sortUserCredentialsByPriority(credentials) {
+			types = append(types, credential.Type)
+		}
+
+		if fmt.Sprint(types) != fmt.Sprint(credentialTypes) {
+			return fmt.Errorf("expected user %s to have credentials %v, got %v", user.Username, credentialTypes, types)
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserCredentialPolicy_removeCredentialTypes(username string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	initial_password {
+		value     = "My password"
+		temporary = false
+	}
+
+	initial_otp {
+		secret = "0123456789abcdef0123"
+	}
+}
+
+resource "keycloak_user_credential_policy" "policy" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+
+	remove_credential_types = ["otp"]
+}
+	`, testAccRealm.Realm, username)
+}
+
+func testKeycloakUserCredentialPolicy_priorityAndLabels(username, otpLabel string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	initial_password {
+		value     = "My password"
+		temporary = false
+	}
+
+	initial_otp {
+		secret = "0123456789abcdef0123"
+	}
+}
+
+data "keycloak_user_credentials" "credentials" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+}
+
+locals {
+	credential_ids = { for credential in data.keycloak_user_credentials.credentials.credentials : credential.type => credential.id }
+}
+
+resource "keycloak_user_credential_policy" "policy" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+
+	credential {
+		id         = local.credential_ids["otp"]
+		user_label = "%s"
+	}
+
+	credential {
+		id = local.credential_ids["password"]
+	}
+}
+	`, testAccRealm.Realm, username, otpLabel)
+}
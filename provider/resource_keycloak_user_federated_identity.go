@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+func resourceKeycloakUserFederatedIdentity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUserFederatedIdentityCreate,
+		ReadContext:   resourceKeycloakUserFederatedIdentityRead,
+		DeleteContext: resourceKeycloakUserFederatedIdentityDelete,
+		// This resource can be imported using {{realm}}/{{userId}}/{{identityProviderAlias}}.
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceKeycloakUserFederatedIdentityImport,
+		},
+		// Keycloak can't update a link, so every change recreates it
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_provider": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The alias of the identity provider.",
+			},
+			"federated_user_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The ID of the user in the identity provider.",
+			},
+			"federated_username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "The username of the user in the identity provider.",
+			},
+		},
+	}
+}
+
+func userFederatedIdentityId(realmId, userId, identityProvider string) string {
+	return fmt.Sprintf("%s/%s/%s", realmId, userId, identityProvider)
+}
+
+func setUserFederatedIdentityData(data *schema.ResourceData, realmId, userId string, federatedIdentity *keycloak.FederatedIdentity) {
+	data.SetId(userFederatedIdentityId(realmId, userId, federatedIdentity.IdentityProvider))
+
+	data.Set("realm_id", realmId)
+	data.Set("user_id", userId)
+	data.Set("identity_provider", federatedIdentity.IdentityProvider)
+	data.Set("federated_user_id", federatedIdentity.UserId)
+	data.Set("federated_username", federatedIdentity.UserName)
+}
+
+func resourceKeycloakUserFederatedIdentityCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	federatedIdentity := &keycloak.FederatedIdentity{
+		IdentityProvider: data.Get("identity_provider").(string),
+		UserId:           data.Get("federated_user_id").(string),
+		UserName:         data.Get("federated_username").(string),
+	}
+
+	err := keycloakClient.NewUserFederatedIdentity(ctx, realmId, userId, federatedIdentity)
+	if err != nil {
+		if keycloak.ErrorIs409(err) {
+			return diag.Errorf("user %s is already linked to identity provider %s, the link can be imported with the id %s", userId, federatedIdentity.IdentityProvider, userFederatedIdentityId(realmId, userId, federatedIdentity.IdentityProvider))
+		}
+
+		return diag.FromErr(err)
+	}
+
+	setUserFederatedIdentityData(data, realmId, userId, federatedIdentity)
+
+	return resourceKeycloakUserFederatedIdentityRead(ctx, data, meta)
+}
+
+func resourceKeycloakUserFederatedIdentityRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	identityProvider := data.Get("identity_provider").(string)
+
+	federatedIdentity, err := keycloakClient.GetUserFederatedIdentity(ctx, realmId, userId, identityProvider)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	if federatedIdentity == nil {
+		data.SetId("")
+		return nil
+	}
+
+	setUserFederatedIdentityData(data, realmId, userId, federatedIdentity)
+
+	return nil
+}
+
+func resourceKeycloakUserFederatedIdentityDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	userId := data.Get("user_id").(string)
+	identityProvider := data.Get("identity_provider").(string)
+
+	err := keycloakClient.DeleteUserFederatedIdentity(ctx, realmId, userId, identityProvider)
+	if err != nil && !keycloak.ErrorIs404(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceKeycloakUserFederatedIdentityImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("Invalid import. Supported import format: {{realm}}/{{userId}}/{{identityProviderAlias}}.")
+	}
+
+	realmId := parts[0]
+	userId := parts[1]
+	identityProvider := parts[2]
+
+	federatedIdentity, err := keycloakClient.GetUserFederatedIdentity(ctx, realmId, userId, identityProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	if federatedIdentity == nil {
+		return nil, fmt.Errorf("user %s is not linked to identity provider %s", userId, identityProvider)
+	}
+
+	setUserFederatedIdentityData(d, realmId, userId, federatedIdentity)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccKeycloakUserFederatedIdentity_basic(t *testing.T) {
+	t.Parallel()
+	username := acctest.RandomWithPrefix("tf-acc")
+	alias := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakUserFederatedIdentityDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(username, alias, "remote-id", "remote-username", "Bob"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_federated_identity.link", "federated_user_id", "remote-id"),
+					resource.TestCheckResourceAttr("data.keycloak_user_federated_identities.links", "federated_identities.#", "1"),
+					resource.TestCheckResourceAttr("data.keycloak_user_federated_identities.links", "federated_identities.0.identity_provider", alias),
+					resource.TestCheckResourceAttr("data.keycloak_user_federated_identities.links", "federated_identities.0.federated_username", "remote-username"),
+				),
+			},
+			{
+				ResourceName:      "keycloak_user_federated_identity.link",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// updating the user must keep the link, it isn't part of the user's configuration
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(username, alias, "remote-id", "remote-username", "Robert"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user.user", "first_name", "Robert"),
+					testAccCheckKeycloakUserFederatedIdentityExists("keycloak_user_federated_identity.link"),
+				),
+			},
+			{
+				Config: testKeycloakUserFederatedIdentity_basic(username, alias, "other-remote-id", "other-remote-username", "Robert"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_user_federated_identity.link", "federated_user_id", "other-remote-id"),
+					testAccCheckKeycloakUserFederatedIdentityExists("keycloak_user_federated_identity.link"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUserFederatedIdentityExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		realmId := rs.Primary.Attributes["realm_id"]
+		userId := rs.Primary.Attributes["user_id"]
+		identityProvider := rs.Primary.Attributes["identity_provider"]
+
+		federatedIdentity, err := keycloakClient.GetUserFederatedIdentity(testCtx, realmId, userId, identityProvider)
+		if err != nil {
+			return err
+		}
+
+		if federatedIdentity == nil {
+			return fmt.Errorf("user %s is not linked to identity provider %s", userId, identityProvider)
+		}
+
+		if federatedIdentity.UserId != rs.Primary.Attributes["federated_user_id"] {
+			return fmt.Errorf("expected federated user id %s, got %s", rs.Primary.Attributes["federated_user_id"], federatedIdentity.UserId)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeycloakUserFederatedIdentityDestroy() resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "keycloak_user_federated_identity" {
+				continue
+			}
+
+			realmId := rs.Primary.Attributes["realm_id"]
+			userId := rs.Primary.Attributes["user_id"]
+			identityProvider := rs.Primary.Attributes["identity_provider"]
+
+			federatedIdentities, err := keycloakClient.GetUserFederatedIdentities(testCtx, realmId, userId)
+			if err != nil {
+				// the user was deleted along with its links
+				continue
+			}
+
+			for _, federatedIdentity := range federatedIdentities {
+				if federatedIdentity.IdentityProvider == identityProvider {
+					return fmt.Errorf("user %s is still linked to identity provider %s", userId, identityProvider)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUserFederatedIdentity_basic(username, alias, federatedUserId, federatedUsername, firstName string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_oidc_identity_provider" "idp" {
+	realm             = data.keycloak_realm.realm.id
+	alias             = "%s"
+	authorization_url = "https://example.com/auth"
+	token_url         = "https://example.com/token"
+	client_id         = "example_id"
+	client_secret     = "example_token"
+}
+
+resource "keycloak_user" "user" {
+	realm_id   = data.keycloak_realm.realm.id
+	username   = "%s"
+	first_name = "%s"
+}
+
+resource "keycloak_user_federated_identity" "link" {
+	realm_id           = data.keycloak_realm.realm.id
+	user_id            = keycloak_user.user.id
+	identity_provider  = keycloak_oidc_identity_provider.idp.alias
+	federated_user_id  = "%s"
+	federated_username = "%s"
+}
+
+data "keycloak_user_federated_identities" "links" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+
+	depends_on = [
+		keycloak_user_federated_identity.link,
+	]
+}
+	`, testAccRealm.Realm, alias, username, firstName, federatedUserId, federatedUsername)
+}
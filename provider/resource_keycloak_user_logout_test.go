@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccKeycloakUserLogout_basic(t *testing.T) {
+	t.Parallel()
+
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUserLogout_basic(username, "1"),
+				Check:  resource.TestCheckResourceAttrPair("keycloak_user_logout.logout", "user_id", "keycloak_user.user", "id"),
+			},
+			{
+				Config: testKeycloakUserLogout_basic(username, "2"),
+				Check:  resource.TestCheckResourceAttr("keycloak_user_logout.logout", "revision", "2"),
+			},
+		},
+	})
+}
+
+func testKeycloakUserLogout_basic(username, revision string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+}
+
+resource "keycloak_user_logout" "logout" {
+	realm_id = data.keycloak_realm.realm.id
+	user_id  = keycloak_user.user.id
+	revision = "%s"
+}
+	`, testAccRealm.Realm, username, revision)
+}
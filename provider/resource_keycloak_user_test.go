@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/http"
@@ -89,6 +92,40 @@ func TestAccKeycloakUser_withInitialPassword(t *testing.T) {
 	})
 }
 
+func TestAccKeycloakUser_withInitialPasswordHash(t *testing.T) {
+	username := acctest.RandomWithPrefix("tf-acc")
+	password := acctest.RandomWithPrefix("tf-acc")
+	clientId := acctest.RandomWithPrefix("tf-acc")
+
+	salt := []byte(acctest.RandString(16))
+	hash, err := pbkdf2.Key(sha256.New, password, salt, 27500, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceName := "keycloak_user.user"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				// bcrypt hashes embed their salt and aren't base64 encoded
+				Config:      testKeycloakUser_initialPasswordHash(username, "bcrypt", "$2a$10$N9qo8uLOickgx2ZMRZoMyeIjZAgcfl7p92ldGxad68LJZdL17lhWy", "", clientId),
+				ExpectError: regexp.MustCompile(`password hashing algorithm "bcrypt" does not exist on the server`),
+			},
+			{
+				Config: testKeycloakUser_initialPasswordHash(username, "pbkdf2-sha256", base64.StdEncoding.EncodeToString(hash), base64.StdEncoding.EncodeToString(salt), clientId),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserExists(resourceName),
+					testAccCheckKeycloakUserInitialPasswordLogin(username, password, clientId),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKeycloakUser_createAfterManualDestroy(t *testing.T) {
 	var user = &keycloak.User{}
 
@@ -300,6 +337,13 @@ func TestAccKeycloakUser_federatedLink(t *testing.T) {
 				Config: testKeycloakUser_FederationLink(sourceUserName2, destinationRealmName),
 				Check:  testAccCheckKeycloakUserHasFederationLinkWithSourceUserName(resourceName, sourceUserName2),
 			},
+			{
+				Config: testKeycloakUser_FederationLinkRemoved(sourceUserName2, destinationRealmName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserHasNoFederationLinks(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "federated_identity.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -436,6 +480,21 @@ func testAccCheckKeycloakUserHasFederationLinkWithSourceUserName(resourceName, s
 	}
 }
 
+func testAccCheckKeycloakUserHasNoFederationLinks(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		fetchedUser, err := getUserFromState(s, resourceName)
+		if err != nil {
+			return err
+		}
+
+		if len(fetchedUser.FederatedIdentities) != 0 {
+			return fmt.Errorf("expected user to have no federatedLinks, but it had %d", len(fetchedUser.FederatedIdentities))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckKeycloakUserExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, err := getUserFromState(s, resourceName)
@@ -635,6 +694,41 @@ resource "keycloak_user" "user" {
 	`, testAccRealm.Realm, userProfile, clientId, username, password, dependsOn)
 }
 
+func testKeycloakUser_initialPasswordHash(username, algorithm, hash, salt, clientId string) string {
+	userProfile, dependsOn := userProfile("data.keycloak_realm.realm.id")
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+%s
+
+resource "keycloak_openid_client" "client" {
+	realm_id                     = data.keycloak_realm.realm.id
+	client_id                    = "%s"
+
+	name                         = "test client"
+	enabled                      = true
+
+	access_type                  = "PUBLIC"
+	direct_access_grants_enabled = true
+}
+
+resource "keycloak_user" "user" {
+	realm_id = data.keycloak_realm.realm.id
+	username = "%s"
+
+	initial_password_hash {
+		algorithm  = "%s"
+		hash       = "%s"
+		salt       = "%s"
+		iterations = 27500
+	}
+	%s
+}
+	`, testAccRealm.Realm, userProfile, clientId, username, algorithm, hash, salt, dependsOn)
+}
+
 func testKeycloakUser_fromInterface(user *keycloak.User) string {
 	userProfile, dependsOn := userProfile("data.keycloak_realm.realm.id")
 	return fmt.Sprintf(`
@@ -717,6 +811,12 @@ resource "keycloak_user" "destination_user" {
 	`, userProfile, sourceRealmUserName, dependsOn, destinationRealmId, dependsOn)
 }
 
+// testKeycloakUser_FederationLinkRemoved returns the resources of testKeycloakUser_FederationLink without the
+// federated_identity block of the destination user
+func testKeycloakUser_FederationLinkRemoved(sourceRealmUserName, destinationRealmId string) string {
+	return regexp.MustCompile(`(?s)\n  federated_identity \{.*?\n  \}`).ReplaceAllString(testKeycloakUser_FederationLink(sourceRealmUserName, destinationRealmId), "")
+}
+
 func testKeycloakUser_import(realmId, username string) string {
 	return fmt.Sprintf(`
 data "keycloak_realm" "realm" {
@@ -729,3 +829,94 @@ resource "keycloak_user" "user" {
 }
 	`, realmId, username)
 }
+
+func TestAccKeycloakUser_validateUserProfile(t *testing.T) {
+	skipIfVersionIsLessThan(testCtx, t, keycloakClient, keycloak.Version_24)
+
+	realmName := acctest.RandomWithPrefix("tf-acc")
+	username := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakUserDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUser_validateUserProfile(realmName, ""),
+			},
+			{
+				Config:      testKeycloakUser_validateUserProfile(realmName, testKeycloakUser_userProfileUser(username, "not-an-email", "attributes = {}")),
+				ExpectError: regexp.MustCompile(`attribute "department" is required by the user profile`),
+			},
+			{
+				Config:      testKeycloakUser_validateUserProfile(realmName, testKeycloakUser_userProfileUser(username, "not-an-email", `attributes = { department = "sales" }`)),
+				ExpectError: regexp.MustCompile(`value "not-an-email" of attribute "email" is not a valid email address`),
+			},
+			{
+				Config:      testKeycloakUser_validateUserProfile(realmName, testKeycloakUser_userProfileUser(username, "bob@example.com", `attributes = { department = "marketing" }`)),
+				ExpectError: regexp.MustCompile(`value "marketing" of attribute "department" must be one of sales, engineering`),
+			},
+			{
+				Config:      testKeycloakUser_validateUserProfile(realmName, testKeycloakUser_userProfileUser(username, "bob@example.com", `attributes = { department = "sales##engineering" }`)),
+				ExpectError: regexp.MustCompile(`attribute "department" is not multivalued in the user profile, but has 2 values`),
+			},
+			{
+				Config: testKeycloakUser_validateUserProfile(realmName, testKeycloakUser_userProfileUser(username, "bob@example.com", `attributes = { department = "sales" }`)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeycloakUserExists("keycloak_user.user"),
+					resource.TestCheckResourceAttr("keycloak_user.user", "attributes.department", "sales"),
+				),
+			},
+		},
+	})
+}
+
+func testKeycloakUser_userProfileUser(username, email, attributes string) string {
+	return fmt.Sprintf(`
+resource "keycloak_user" "user" {
+	realm_id = keycloak_realm.realm.id
+	username = "%s"
+	email    = "%s"
+	%s
+
+	depends_on = [keycloak_realm_user_profile.realm_user_profile]
+}
+	`, username, email, attributes)
+}
+
+func testKeycloakUser_validateUserProfile(realmName, user string) string {
+	return fmt.Sprintf(`
+resource "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_realm_user_profile" "realm_user_profile" {
+	realm_id = keycloak_realm.realm.id
+
+	attribute {
+		name = "username"
+	}
+
+	attribute {
+		name = "email"
+
+		validator {
+			name = "email"
+		}
+	}
+
+	attribute {
+		name               = "department"
+		required_for_roles = ["admin", "user"]
+
+		validator {
+			name = "options"
+			config = {
+				options = jsonencode(["sales", "engineering"])
+			}
+		}
+	}
+}
+%s
+	`, realmName, user)
+}
@@ -0,0 +1,963 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/keycloak/terraform-provider-keycloak/keycloak"
+)
+
+// bulkUser is a user managed by the keycloak_users resource. The JSON tags define the format of users_json.
+type bulkUser struct {
+	Username                 string              `json:"username"`
+	Email                    string              `json:"email"`
+	EmailVerified            bool                `json:"email_verified"`
+	FirstName                string              `json:"first_name"`
+	LastName                 string              `json:"last_name"`
+	Enabled                  bool                `json:"enabled"`
+	Attributes               map[string][]string `json:"attributes"`
+	GroupIds                 []string            `json:"group_ids"`
+	RealmRoles               []string            `json:"realm_roles"`
+	ClientRoles              map[string][]string `json:"client_roles"`
+	InitialPassword          string              `json:"initial_password"`
+	InitialPasswordTemporary bool                `json:"initial_password_temporary"`
+}
+
+func resourceKeycloakUsers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceKeycloakUsersCreate,
+		ReadContext:   resourceKeycloakUsersRead,
+		UpdateContext: resourceKeycloakUsersUpdate,
+		DeleteContext: resourceKeycloakUsersDelete,
+		CustomizeDiff: resourceKeycloakUsersCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"realm_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			// computed, because it is filled in from users_json or users_csv when they are used
+			"user": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"users_json", "users_csv"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"email_verified": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"attributes": {
+							Type:     schema.TypeMap,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Optional: true,
+							// ignore ordering of multi-valued attributes
+							DiffSuppressFunc: suppressDiffForMultivalueAttributeOrder(),
+						},
+						"group_ids": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Optional: true,
+						},
+						"realm_roles": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      schema.HashString,
+							Optional: true,
+						},
+						"client_roles": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"roles": {
+										Type:     schema.TypeSet,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+										Set:      schema.HashString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"initial_password": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"initial_password_temporary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"users_json": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"user", "users_csv"},
+				ValidateFunc:  validation.StringIsJSON,
+			},
+			"users_csv": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"user", "users_json"},
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      4,
+				ValidateFunc: validation.IntBetween(1, 32),
+			},
+			"user_ids": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func splitBulkUserValues(value string) []string {
+	var values []string
+	for _, v := range strings.Split(value, MULTIVALUE_ATTRIBUTE_SEPARATOR) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+func parseBulkUsersJson(usersJson string) ([]*bulkUser, error) {
+	var rawUsers []json.RawMessage
+	err := json.Unmarshal([]byte(usersJson), &rawUsers)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse users_json, expected a list of users: %v", err)
+	}
+
+	var users []*bulkUser
+	for i, rawUser := range rawUsers {
+		user := &bulkUser{
+			Enabled: true,
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(rawUser))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(user)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse user %d of users_json: %v", i, err)
+		}
+
+		if user.Username == "" {
+			return nil, fmt.Errorf("user %d of users_json has no username", i)
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+var bulkUsersCsvColumns = []string{
+	"username",
+	"email",
+	"email_verified",
+	"first_name",
+	"last_name",
+	"enabled",
+	"group_ids",
+	"realm_roles",
+	"client_roles",
+	"initial_password",
+	"initial_password_temporary",
+}
+
+// parseBulkUsersCsv parses users from a CSV document with a header row. Multiple values in a cell are separated by
+// "##", client roles are written as "<client id>/<role name>" and attributes use "attributes.<name>" columns.
+func parseBulkUsersCsv(usersCsv string) ([]*bulkUser, error) {
+	reader := csv.NewReader(strings.NewReader(usersCsv))
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse users_csv: %v", err)
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	if !slices.Contains(header, "username") {
+		return nil, fmt.Errorf("users_csv must have a username column")
+	}
+
+	for _, column := range header {
+		if !slices.Contains(bulkUsersCsvColumns, column) && !strings.HasPrefix(column, "attributes.") {
+			return nil, fmt.Errorf("users_csv has an unknown column %s", column)
+		}
+	}
+
+	var users []*bulkUser
+	for i, record := range records[1:] {
+		line := i + 2
+		user := &bulkUser{
+			Enabled:     true,
+			Attributes:  map[string][]string{},
+			ClientRoles: map[string][]string{},
+		}
+
+		for j, column := range header {
+			value := strings.TrimSpace(record[j])
+			if value == "" {
+				continue
+			}
+
+			switch column {
+			case "username":
+				user.Username = value
+			case "email":
+				user.Email = value
+			case "first_name":
+				user.FirstName = value
+			case "last_name":
+				user.LastName = value
+			case "initial_password":
+				user.InitialPassword = value
+			case "email_verified", "enabled", "initial_password_temporary":
+				b, err := strconv.ParseBool(value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d of users_csv: invalid value \"%s\" for column %s", line, value, column)
+				}
+
+				switch column {
+				case "email_verified":
+					user.EmailVerified = b
+				case "enabled":
+					user.Enabled = b
+				default:
+					user.InitialPasswordTemporary = b
+				}
+			case "group_ids":
+				user.GroupIds = splitBulkUserValues(value)
+			case "realm_roles":
+				user.RealmRoles = splitBulkUserValues(value)
+			case "client_roles":
+				for _, clientRole := range splitBulkUserValues(value) {
+					clientId, roleName, ok := strings.Cut(clientRole, "/")
+					if !ok {
+						return nil, fmt.Errorf("line %d of users_csv: client role \"%s\" must be written as <client id>/<role name>", line, clientRole)
+					}
+
+					user.ClientRoles[clientId] = append(user.ClientRoles[clientId], roleName)
+				}
+			default:
+				user.Attributes[strings.TrimPrefix(column, "attributes.")] = splitBulkUserValues(value)
+			}
+		}
+
+		if user.Username == "" {
+			return nil, fmt.Errorf("line %d of users_csv has no username", line)
+		}
+
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+func validateBulkUsers(users []*bulkUser) error {
+	usernames := map[string]bool{}
+	for _, user := range users {
+		// usernames can be unknown at plan time
+		if user.Username == "" {
+			continue
+		}
+
+		if strings.ToLower(user.Username) != user.Username {
+			return fmt.Errorf("validation error: username \"%s\" must be lower case, Keycloak stores usernames in lower case", user.Username)
+		}
+
+		if usernames[user.Username] {
+			return fmt.Errorf("validation error: user \"%s\" is defined more than once", user.Username)
+		}
+		usernames[user.Username] = true
+	}
+
+	return nil
+}
+
+func getBulkUsersFromList(list []interface{}) []*bulkUser {
+	var users []*bulkUser
+	for _, u := range list {
+		userData := u.(map[string]interface{})
+
+		attributes := map[string][]string{}
+		for key, value := range userData["attributes"].(map[string]interface{}) {
+			attributes[key] = strings.Split(value.(string), MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		clientRoles := map[string][]string{}
+		for _, c := range userData["client_roles"].(*schema.Set).List() {
+			clientRole := c.(map[string]interface{})
+			clientId := clientRole["client_id"].(string)
+			clientRoles[clientId] = append(clientRoles[clientId], interfaceSliceToStringSlice(clientRole["roles"].(*schema.Set).List())...)
+		}
+
+		users = append(users, &bulkUser{
+			Username:                 userData["username"].(string),
+			Email:                    userData["email"].(string),
+			EmailVerified:            userData["email_verified"].(bool),
+			FirstName:                userData["first_name"].(string),
+			LastName:                 userData["last_name"].(string),
+			Enabled:                  userData["enabled"].(bool),
+			Attributes:               attributes,
+			GroupIds:                 interfaceSliceToStringSlice(userData["group_ids"].(*schema.Set).List()),
+			RealmRoles:               interfaceSliceToStringSlice(userData["realm_roles"].(*schema.Set).List()),
+			ClientRoles:              clientRoles,
+			InitialPassword:          userData["initial_password"].(string),
+			InitialPasswordTemporary: userData["initial_password_temporary"].(bool),
+		})
+	}
+
+	return users
+}
+
+func bulkUsersToList(users []*bulkUser) []interface{} {
+	list := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		attributes := map[string]interface{}{}
+		for key, values := range user.Attributes {
+			values = slices.Clone(values)
+			slices.Sort(values)
+			attributes[key] = strings.Join(values, MULTIVALUE_ATTRIBUTE_SEPARATOR)
+		}
+
+		var clientRoles []interface{}
+		for _, clientId := range slices.Sorted(maps.Keys(user.ClientRoles)) {
+			if len(user.ClientRoles[clientId]) == 0 {
+				continue
+			}
+
+			clientRoles = append(clientRoles, map[string]interface{}{
+				"client_id": clientId,
+				"roles":     user.ClientRoles[clientId],
+			})
+		}
+
+		list = append(list, map[string]interface{}{
+			"username":                   user.Username,
+			"email":                      user.Email,
+			"email_verified":             user.EmailVerified,
+			"first_name":                 user.FirstName,
+			"last_name":                  user.LastName,
+			"enabled":                    user.Enabled,
+			"attributes":                 attributes,
+			"group_ids":                  user.GroupIds,
+			"realm_roles":                user.RealmRoles,
+			"client_roles":               clientRoles,
+			"initial_password":           user.InitialPassword,
+			"initial_password_temporary": user.InitialPasswordTemporary,
+		})
+	}
+
+	return list
+}
+
+func resourceKeycloakUsersCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("users_json") || !diff.NewValueKnown("users_csv") {
+		return diff.SetNewComputed("user")
+	}
+
+	var users []*bulkUser
+	var err error
+
+	if usersJson := diff.Get("users_json").(string); usersJson != "" {
+		users, err = parseBulkUsersJson(usersJson)
+	} else if usersCsv := diff.Get("users_csv").(string); usersCsv != "" {
+		users, err = parseBulkUsersCsv(usersCsv)
+	} else {
+		// "user" is computed, so removing all of its blocks wouldn't show up in the plan otherwise
+		rawConfig := diff.GetRawConfig()
+		if !rawConfig.IsNull() && rawConfig.IsKnown() {
+			userConfig := rawConfig.GetAttr("user")
+			if userConfig.IsKnown() && (userConfig.IsNull() || userConfig.LengthInt() == 0) {
+				return diff.SetNew("user", []interface{}{})
+			}
+		}
+
+		return validateBulkUsers(getBulkUsersFromList(diff.Get("user").([]interface{})))
+	}
+	if err != nil {
+		return err
+	}
+
+	err = validateBulkUsers(users)
+	if err != nil {
+		return err
+	}
+
+	return diff.SetNew("user", bulkUsersToList(users))
+}
+
+// bulkUsersMemberships holds the groups and roles of the users of a realm, limited to the groups and roles managed by
+// a keycloak_users resource. The memberships are keyed by user id.
+type bulkUsersMemberships struct {
+	groupIds    map[string][]string
+	realmRoles  map[string][]string
+	clientRoles map[string]map[string][]string
+}
+
+type bulkUsersState struct {
+	users       map[string]*keycloak.User
+	memberships *bulkUsersMemberships
+
+	managedGroupIds    []string
+	managedRealmRoles  []string
+	managedClientRoles map[string][]string
+}
+
+func getBulkUsersManagedMemberships(users []*bulkUser) ([]string, []string, map[string][]string) {
+	var groupIds, realmRoles []string
+	clientRoles := map[string][]string{}
+
+	for _, user := range users {
+		for _, groupId := range user.GroupIds {
+			if !slices.Contains(groupIds, groupId) {
+				groupIds = append(groupIds, groupId)
+			}
+		}
+
+		for _, roleName := range user.RealmRoles {
+			if !slices.Contains(realmRoles, roleName) {
+				realmRoles = append(realmRoles, roleName)
+			}
+		}
+
+		for clientId, roleNames := range user.ClientRoles {
+			for _, roleName := range roleNames {
+				if !slices.Contains(clientRoles[clientId], roleName) {
+					clientRoles[clientId] = append(clientRoles[clientId], roleName)
+				}
+			}
+		}
+	}
+
+	return groupIds, realmRoles, clientRoles
+}
+
+// getBulkUsersState reads all the users of a realm, and the members of every group and role assigned to the given
+// users. Everything is read page by page, which is much cheaper than reading the groups and roles of every user.
+func getBulkUsersState(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, users []*bulkUser) (*bulkUsersState, error) {
+	realmUsers, err := keycloakClient.GetAllUsers(ctx, realmId)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &bulkUsersState{
+		users: map[string]*keycloak.User{},
+		memberships: &bulkUsersMemberships{
+			groupIds:    map[string][]string{},
+			realmRoles:  map[string][]string{},
+			clientRoles: map[string]map[string][]string{},
+		},
+	}
+	state.managedGroupIds, state.managedRealmRoles, state.managedClientRoles = getBulkUsersManagedMemberships(users)
+
+	for _, user := range realmUsers {
+		state.users[user.Username] = user
+	}
+
+	// groups and roles that were deleted since the last apply don't have members anymore
+	for _, groupId := range state.managedGroupIds {
+		members, err := keycloakClient.GetGroupMembers(ctx, realmId, groupId)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return nil, err
+		}
+
+		for _, member := range members {
+			state.memberships.groupIds[member.Id] = append(state.memberships.groupIds[member.Id], groupId)
+		}
+	}
+
+	for _, roleName := range state.managedRealmRoles {
+		members, err := keycloakClient.GetRealmRoleMembers(ctx, realmId, roleName)
+		if err != nil && !keycloak.ErrorIs404(err) {
+			return nil, err
+		}
+
+		for _, member := range members {
+			state.memberships.realmRoles[member.Id] = append(state.memberships.realmRoles[member.Id], roleName)
+		}
+	}
+
+	for clientId, roleNames := range state.managedClientRoles {
+		for _, roleName := range roleNames {
+			members, err := keycloakClient.GetClientRoleMembers(ctx, realmId, clientId, roleName)
+			if err != nil && !keycloak.ErrorIs404(err) {
+				return nil, err
+			}
+
+			for _, member := range members {
+				if state.memberships.clientRoles[member.Id] == nil {
+					state.memberships.clientRoles[member.Id] = map[string][]string{}
+				}
+				state.memberships.clientRoles[member.Id][clientId] = append(state.memberships.clientRoles[member.Id][clientId], roleName)
+			}
+		}
+	}
+
+	return state, nil
+}
+
+// getUserMemberships reads the managed groups and roles of a single user, used for users that were just created and
+// may already have some default groups and roles
+func (state *bulkUsersState) getUserMemberships(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId, userId string) ([]string, []string, map[string][]string, error) {
+	var groupIds, realmRoles []string
+	clientRoles := map[string][]string{}
+
+	if len(state.managedGroupIds) != 0 {
+		groups, err := keycloakClient.GetUserGroups(ctx, realmId, userId)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		for _, group := range groups {
+			if slices.Contains(state.managedGroupIds, group.Id) {
+				groupIds = append(groupIds, group.Id)
+			}
+		}
+	}
+
+	if len(state.managedRealmRoles) != 0 || len(state.managedClientRoles) != 0 {
+		roleMappings, err := keycloakClient.GetUserRoleMappings(ctx, realmId, userId)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		for _, role := range roleMappings.RealmMappings {
+			if slices.Contains(state.managedRealmRoles, role.Name) {
+				realmRoles = append(realmRoles, role.Name)
+			}
+		}
+
+		for _, clientRoleMapping := range roleMappings.ClientMappings {
+			for _, role := range clientRoleMapping.Mappings {
+				if slices.Contains(state.managedClientRoles[clientRoleMapping.Id], role.Name) {
+					clientRoles[clientRoleMapping.Id] = append(clientRoles[clientRoleMapping.Id], role.Name)
+				}
+			}
+		}
+	}
+
+	return groupIds, realmRoles, clientRoles, nil
+}
+
+// bulkUser returns the current state of a managed user, or nil if the user doesn't exist. Initial passwords can't be
+// read back, so they are kept from the managed user.
+func (state *bulkUsersState) bulkUser(managed *bulkUser) *bulkUser {
+	user, ok := state.users[managed.Username]
+	if !ok {
+		return nil
+	}
+
+	attributes := user.Attributes
+	if attributes == nil {
+		attributes = map[string][]string{}
+	}
+
+	return &bulkUser{
+		Username:                 user.Username,
+		Email:                    user.Email,
+		EmailVerified:            user.EmailVerified,
+		FirstName:                user.FirstName,
+		LastName:                 user.LastName,
+		Enabled:                  user.Enabled,
+		Attributes:               attributes,
+		GroupIds:                 state.memberships.groupIds[user.Id],
+		RealmRoles:               state.memberships.realmRoles[user.Id],
+		ClientRoles:              state.memberships.clientRoles[user.Id],
+		InitialPassword:          managed.InitialPassword,
+		InitialPasswordTemporary: managed.InitialPasswordTemporary,
+	}
+}
+
+func bulkUserDetailsChanged(user *bulkUser, current *keycloak.User) bool {
+	return user.Email != current.Email ||
+		user.EmailVerified != current.EmailVerified ||
+		user.FirstName != current.FirstName ||
+		user.LastName != current.LastName ||
+		user.Enabled != current.Enabled ||
+		!multivalueAttributesEqual(user.Attributes, current.Attributes)
+}
+
+func bulkUserRoleKey(clientId, roleName string) string {
+	return clientId + "/" + roleName
+}
+
+// getBulkUsersRoles looks up every managed role by name. Roles that don't exist are left out.
+func getBulkUsersRoles(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, state *bulkUsersState) (map[string]*keycloak.Role, error) {
+	roles := map[string]*keycloak.Role{}
+
+	lookup := func(clientId, roleName string) error {
+		role, err := keycloakClient.GetRoleByName(ctx, realmId, clientId, roleName)
+		if err != nil {
+			if keycloak.ErrorIs404(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		roles[bulkUserRoleKey(clientId, roleName)] = role
+
+		return nil
+	}
+
+	for _, roleName := range state.managedRealmRoles {
+		if err := lookup("", roleName); err != nil {
+			return nil, err
+		}
+	}
+
+	for clientId, roleNames := range state.managedClientRoles {
+		for _, roleName := range roleNames {
+			if err := lookup(clientId, roleName); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return roles, nil
+}
+
+func getBulkUserRoles(roles map[string]*keycloak.Role, clientId string, roleNames []string, mustExist bool) ([]*keycloak.Role, error) {
+	var result []*keycloak.Role
+	for _, roleName := range roleNames {
+		role, ok := roles[bulkUserRoleKey(clientId, roleName)]
+		if !ok {
+			if !mustExist {
+				continue
+			}
+
+			if clientId == "" {
+				return nil, fmt.Errorf("realm role %s does not exist", roleName)
+			}
+
+			return nil, fmt.Errorf("role %s of client %s does not exist", roleName, clientId)
+		}
+
+		result = append(result, role)
+	}
+
+	return result, nil
+}
+
+func reconcileBulkUser(ctx context.Context, keycloakClient *keycloak.KeycloakClient, realmId string, user *bulkUser, state *bulkUsersState, roles map[string]*keycloak.Role) error {
+	var userId string
+	var currentGroupIds, currentRealmRoles []string
+	var currentClientRoles map[string][]string
+
+	if current, ok := state.users[user.Username]; ok {
+		userId = current.Id
+		currentGroupIds = state.memberships.groupIds[userId]
+		currentRealmRoles = state.memberships.realmRoles[userId]
+		currentClientRoles = state.memberships.clientRoles[userId]
+
+		if bulkUserDetailsChanged(user, current) {
+			// the full representation keeps the required actions and federated identities of the user
+			fetched, err := keycloakClient.GetUser(ctx, realmId, userId)
+			if err != nil {
+				return err
+			}
+
+			fetched.Email = user.Email
+			fetched.EmailVerified = user.EmailVerified
+			fetched.FirstName = user.FirstName
+			fetched.LastName = user.LastName
+			fetched.Enabled = user.Enabled
+			fetched.Attributes = user.Attributes
+
+			err = keycloakClient.UpdateUser(ctx, fetched)
+			if err != nil {
+				return err
+			}
+		}
+	} else {
+		newUser := &keycloak.User{
+			RealmId:       realmId,
+			Username:      user.Username,
+			Email:         user.Email,
+			EmailVerified: user.EmailVerified,
+			FirstName:     user.FirstName,
+			LastName:      user.LastName,
+			Enabled:       user.Enabled,
+			Attributes:    user.Attributes,
+		}
+
+		err := keycloakClient.NewUser(ctx, newUser)
+		if err != nil {
+			return err
+		}
+		userId = newUser.Id
+
+		if user.InitialPassword != "" {
+			err = keycloakClient.ResetUserPassword(ctx, realmId, userId, user.InitialPassword, user.InitialPasswordTemporary)
+			if err != nil {
+				return err
+			}
+		}
+
+		currentGroupIds, currentRealmRoles, currentClientRoles, err = state.getUserMemberships(ctx, keycloakClient, realmId, userId)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := keycloakClient.AddUserToGroups(ctx, stringArrayDifference(user.GroupIds, currentGroupIds), userId, realmId)
+	if err != nil {
+		return err
+	}
+
+	err = keycloakClient.RemoveUserFromGroups(ctx, stringArrayDifference(currentGroupIds, user.GroupIds), userId, realmId)
+	if err != nil {
+		return err
+	}
+
+	realmRolesToAdd, err := getBulkUserRoles(roles, "", stringArrayDifference(user.RealmRoles, currentRealmRoles), true)
+	if err != nil {
+		return err
+	}
+	if len(realmRolesToAdd) != 0 {
+		err = keycloakClient.AddRealmRolesToUser(ctx, realmId, userId, realmRolesToAdd)
+		if err != nil {
+			return err
+		}
+	}
+
+	realmRolesToRemove, _ := getBulkUserRoles(roles, "", stringArrayDifference(currentRealmRoles, user.RealmRoles), false)
+	if len(realmRolesToRemove) != 0 {
+		err = keycloakClient.RemoveRealmRolesFromUser(ctx, realmId, userId, realmRolesToRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	for clientId := range state.managedClientRoles {
+		clientRolesToAdd, err := getBulkUserRoles(roles, clientId, stringArrayDifference(user.ClientRoles[clientId], currentClientRoles[clientId]), true)
+		if err != nil {
+			return err
+		}
+		if len(clientRolesToAdd) != 0 {
+			err = keycloakClient.AddClientRolesToUser(ctx, realmId, userId, clientId, clientRolesToAdd)
+			if err != nil {
+				return err
+			}
+		}
+
+		clientRolesToRemove, _ := getBulkUserRoles(roles, clientId, stringArrayDifference(currentClientRoles[clientId], user.ClientRoles[clientId]), false)
+		if len(clientRolesToRemove) != 0 {
+			err = keycloakClient.RemoveClientRolesFromUser(ctx, realmId, userId, clientId, clientRolesToRemove)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+type bulkUserTask struct {
+	username string
+	run      func() error
+}
+
+// runBulkUserTasks runs at most parallelism tasks at the same time. A failing task doesn't stop the other ones, the
+// failures are returned as one diagnostic per user.
+func runBulkUserTasks(tasks []bulkUserTask, parallelism int, severity diag.Severity) diag.Diagnostics {
+	errs := runInParallel(len(tasks), parallelism, func(i int) error {
+		return tasks[i].run()
+	})
+
+	var diags diag.Diagnostics
+	for i, err := range errs {
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: severity,
+				Summary:  fmt.Sprintf("error managing user %s", tasks[i].username),
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	return diags
+}
+
+// resourceKeycloakUsersApply creates, updates and deletes users so the realm matches the configuration. Failures of
+// individual users are returned as diagnostics with the given severity, other failures as an error.
+func resourceKeycloakUsersApply(ctx context.Context, data *schema.ResourceData, keycloakClient *keycloak.KeycloakClient, previousUsers []*bulkUser, severity diag.Severity) (diag.Diagnostics, error) {
+	realmId := data.Get("realm_id").(string)
+	parallelism := data.Get("parallelism").(int)
+	users := getBulkUsersFromList(data.Get("user").([]interface{}))
+
+	// groups and roles that were removed from the configuration are still managed, so they are removed from the users
+	state, err := getBulkUsersState(ctx, keycloakClient, realmId, append(slices.Clone(users), previousUsers...))
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := getBulkUsersRoles(ctx, keycloakClient, realmId, state)
+	if err != nil {
+		return nil, err
+	}
+
+	var tasks []bulkUserTask
+	usernames := map[string]bool{}
+	for _, user := range users {
+		usernames[user.Username] = true
+		tasks = append(tasks, bulkUserTask{
+			username: user.Username,
+			run: func() error {
+				return reconcileBulkUser(ctx, keycloakClient, realmId, user, state, roles)
+			},
+		})
+	}
+
+	for _, previousUser := range previousUsers {
+		current, ok := state.users[previousUser.Username]
+		if usernames[previousUser.Username] || !ok {
+			continue
+		}
+
+		tasks = append(tasks, bulkUserTask{
+			username: previousUser.Username,
+			run: func() error {
+				return keycloakClient.DeleteUser(ctx, realmId, current.Id)
+			},
+		})
+	}
+
+	return runBulkUserTasks(tasks, parallelism, severity), nil
+}
+
+func resourceKeycloakUsersCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	// a failing create taints the resource, which would delete all users on the next apply. Failing users are
+	// reported as warnings instead, they are left out of the state and created again on the next apply.
+	diags, err := resourceKeycloakUsersApply(ctx, data, keycloakClient, nil, diag.Warning)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(data.Get("realm_id").(string))
+
+	return append(diags, resourceKeycloakUsersRead(ctx, data, meta)...)
+}
+
+func resourceKeycloakUsersRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	users := getBulkUsersFromList(data.Get("user").([]interface{}))
+
+	state, err := getBulkUsersState(ctx, keycloakClient, realmId, users)
+	if err != nil {
+		return handleNotFoundError(ctx, err, data)
+	}
+
+	var currentUsers []*bulkUser
+	userIds := map[string]string{}
+	for _, user := range users {
+		currentUser := state.bulkUser(user)
+		if currentUser == nil {
+			continue
+		}
+
+		currentUsers = append(currentUsers, currentUser)
+		userIds[user.Username] = state.users[user.Username].Id
+	}
+
+	data.Set("user", bulkUsersToList(currentUsers))
+	data.Set("user_ids", userIds)
+
+	return nil
+}
+
+func resourceKeycloakUsersUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	previousUsers, _ := data.GetChange("user")
+
+	diags, err := resourceKeycloakUsersApply(ctx, data, keycloakClient, getBulkUsersFromList(previousUsers.([]interface{})), diag.Error)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return append(diags, resourceKeycloakUsersRead(ctx, data, meta)...)
+}
+
+func resourceKeycloakUsersDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	keycloakClient := meta.(*keycloak.KeycloakClient)
+
+	realmId := data.Get("realm_id").(string)
+	parallelism := data.Get("parallelism").(int)
+
+	var tasks []bulkUserTask
+	for username, userId := range data.Get("user_ids").(map[string]interface{}) {
+		tasks = append(tasks, bulkUserTask{
+			username: username,
+			run: func() error {
+				err := keycloakClient.DeleteUser(ctx, realmId, userId.(string))
+				if err != nil && !keycloak.ErrorIs404(err) {
+					return err
+				}
+
+				return nil
+			},
+		})
+	}
+
+	return runBulkUserTasks(tasks, parallelism, diag.Error)
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestParseBulkUsersCsv(t *testing.T) {
+	users, err := parseBulkUsersCsv(`username,email,enabled,group_ids,realm_roles,client_roles,attributes.department
+alice,alice@example.com,,group-a##group-b,admin,client-uuid/viewer##client-uuid/editor,sales
+bob,,false,,,,
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(users) != 2 {
+		t.Fatalf("expected 2 users, got %d", len(users))
+	}
+
+	alice := users[0]
+	if alice.Username != "alice" || alice.Email != "alice@example.com" || !alice.Enabled {
+		t.Errorf("unexpected user %+v", alice)
+	}
+	if !slices.Equal(alice.GroupIds, []string{"group-a", "group-b"}) {
+		t.Errorf("unexpected groups %v", alice.GroupIds)
+	}
+	if !slices.Equal(alice.ClientRoles["client-uuid"], []string{"viewer", "editor"}) {
+		t.Errorf("unexpected client roles %v", alice.ClientRoles)
+	}
+	if !slices.Equal(alice.Attributes["department"], []string{"sales"}) {
+		t.Errorf("unexpected attributes %v", alice.Attributes)
+	}
+
+	if users[1].Enabled {
+		t.Errorf("expected bob to be disabled")
+	}
+
+	for _, invalidCsv := range []string{
+		"email\nalice@example.com",
+		"username,unknown\nalice,value",
+		"username,enabled\nalice,maybe",
+		"username,client_roles\nalice,viewer",
+		"username,email\n,alice@example.com",
+	} {
+		if _, err := parseBulkUsersCsv(invalidCsv); err == nil {
+			t.Errorf("expected an error for %q", invalidCsv)
+		}
+	}
+}
+
+func TestParseBulkUsersJson(t *testing.T) {
+	users, err := parseBulkUsersJson(`[
+		{"username": "alice", "attributes": {"department": ["sales"]}, "client_roles": {"client-uuid": ["viewer"]}},
+		{"username": "bob", "enabled": false}
+	]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(users) != 2 || !users[0].Enabled || users[1].Enabled {
+		t.Fatalf("unexpected users %+v", users)
+	}
+
+	for _, invalidJson := range []string{
+		`{"username": "alice"}`,
+		`[{"username": "alice", "unknown": true}]`,
+		`[{"email": "alice@example.com"}]`,
+	} {
+		if _, err := parseBulkUsersJson(invalidJson); err == nil {
+			t.Errorf("expected an error for %q", invalidJson)
+		}
+	}
+}
+
+func TestValidateBulkUsers(t *testing.T) {
+	if err := validateBulkUsers([]*bulkUser{{Username: "alice"}, {Username: ""}, {Username: ""}}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	if err := validateBulkUsers([]*bulkUser{{Username: "alice"}, {Username: "alice"}}); err == nil {
+		t.Errorf("expected an error for duplicate users")
+	}
+
+	if err := validateBulkUsers([]*bulkUser{{Username: "Alice"}}); err == nil {
+		t.Errorf("expected an error for upper case usernames")
+	}
+}
+
+func TestRunBulkUserTasks(t *testing.T) {
+	var running, maxRunning atomic.Int32
+
+	var tasks []bulkUserTask
+	for i := 0; i < 20; i++ {
+		tasks = append(tasks, bulkUserTask{
+			username: fmt.Sprintf("user-%d", i),
+			run: func() error {
+				current := running.Add(1)
+				defer running.Add(-1)
+
+				for {
+					previous := maxRunning.Load()
+					if current <= previous || maxRunning.CompareAndSwap(previous, current) {
+						break
+					}
+				}
+
+				if i%5 == 0 {
+					return errors.New("failed")
+				}
+
+				return nil
+			},
+		})
+	}
+
+	diags := runBulkUserTasks(tasks, 3, diag.Warning)
+
+	if maxRunning.Load() > 3 {
+		t.Errorf("expected at most 3 tasks to run at the same time, got %d", maxRunning.Load())
+	}
+
+	if len(diags) != 4 {
+		t.Fatalf("expected 4 diagnostics, got %d", len(diags))
+	}
+
+	for _, d := range diags {
+		if d.Severity != diag.Warning || !strings.HasPrefix(d.Summary, "error managing user user-") {
+			t.Errorf("unexpected diagnostic %+v", d)
+		}
+	}
+}
+
+func TestAccKeycloakUsers_basic(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+	groupName := acctest.RandomWithPrefix("tf-acc")
+	roleName := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakUsersDestroy(prefix, 3),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUsers_basic(prefix, groupName, roleName, 3, "sales"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_users.users", "user.#", "3"),
+					resource.TestCheckResourceAttr("keycloak_users.users", "user_ids.%", "3"),
+					resource.TestCheckResourceAttr("keycloak_users.users", "user.0.attributes.department", "sales"),
+					resource.TestCheckResourceAttr("keycloak_users.users", "user.0.group_ids.#", "1"),
+					resource.TestCheckResourceAttr("keycloak_users.users", "user.0.realm_roles.#", "1"),
+				),
+			},
+			{
+				Config: testKeycloakUsers_basic(prefix, groupName, roleName, 2, "support"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_users.users", "user.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_users.users", "user_ids.%", "2"),
+					resource.TestCheckResourceAttr("keycloak_users.users", "user.1.attributes.department", "support"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeycloakUsers_csv(t *testing.T) {
+	t.Parallel()
+	prefix := acctest.RandomWithPrefix("tf-acc")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckKeycloakUsersDestroy(prefix, 2),
+		Steps: []resource.TestStep{
+			{
+				Config: testKeycloakUsers_csv(prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("keycloak_users.users", "user.#", "2"),
+					resource.TestCheckResourceAttr("keycloak_users.users", "user.0.email", prefix+"-0@example.com"),
+					resource.TestCheckResourceAttr("keycloak_users.users", "user.1.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeycloakUsersDestroy(prefix string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for i := 0; i < count; i++ {
+			username := fmt.Sprintf("%s-%d", prefix, i)
+
+			user, err := keycloakClient.GetUserByUsername(testCtx, testAccRealm.Realm, username)
+			if err != nil {
+				return err
+			}
+
+			if user != nil {
+				return fmt.Errorf("user %s still exists", username)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testKeycloakUsers_basic(prefix, groupName, roleName string, count int, department string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_group" "group" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_role" "role" {
+	realm_id = data.keycloak_realm.realm.id
+	name     = "%s"
+}
+
+resource "keycloak_users" "users" {
+	realm_id = data.keycloak_realm.realm.id
+
+	dynamic "user" {
+		for_each = range(%d)
+		content {
+			username   = "%s-${user.value}"
+			email      = "%s-${user.value}@example.com"
+			first_name = "User"
+			last_name  = "${user.value}"
+
+			attributes = {
+				department = "%s"
+			}
+
+			group_ids   = [keycloak_group.group.id]
+			realm_roles = [keycloak_role.role.name]
+
+			initial_password = "My password"
+		}
+	}
+}
+	`, testAccRealm.Realm, groupName, roleName, count, prefix, prefix, department)
+}
+
+func testKeycloakUsers_csv(prefix string) string {
+	return fmt.Sprintf(`
+data "keycloak_realm" "realm" {
+	realm = "%s"
+}
+
+resource "keycloak_users" "users" {
+	realm_id  = data.keycloak_realm.realm.id
+	users_csv = <<-EOT
+		username,email,enabled
+		%s-0,%s-0@example.com,true
+		%s-1,%s-1@example.com,false
+	EOT
+}
+	`, testAccRealm.Realm, prefix, prefix, prefix, prefix)
+}
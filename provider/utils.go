@@ -4,6 +4,7 @@ import (
 	"context"
 	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -156,3 +157,51 @@ func suppressDiffWhenNotInConfig(attrName string) schema.SchemaDiffSuppressFunc
 func intPointer(i int) *int {
 	return &i
 }
+
+// runInParallel calls run for every index below count, with at most parallelism calls running at the same time, and
+// returns the error of each call
+func runInParallel(count, parallelism int, run func(i int) error) []error {
+	errs := make([]error, count)
+	semaphore := make(chan struct{}, parallelism)
+
+	var wg sync.WaitGroup
+	for i := range count {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			errs[i] = run(i)
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// multivalueAttributesEqual compares attributes regardless of the order of their values
+func multivalueAttributesEqual(a, b map[string][]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, values := range a {
+		otherValues, ok := b[key]
+		if !ok {
+			return false
+		}
+
+		values = slices.Clone(values)
+		otherValues = slices.Clone(otherValues)
+		slices.Sort(values)
+		slices.Sort(otherValues)
+
+		if !slices.Equal(values, otherValues) {
+			return false
+		}
+	}
+
+	return true
+}